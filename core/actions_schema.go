@@ -0,0 +1,133 @@
+package core
+
+type GhRepoActionsConfig struct {
+	GhActionsConfig `yaml:",inline"`
+	Permissions     *GhActionsPermissionsConfig `yaml:"permissions,omitempty"`
+}
+
+func (to *GhRepoActionsConfig) Merge(from *GhRepoActionsConfig) {
+	if from == nil {
+		return
+	}
+
+	to.GhActionsConfig.Merge(&from.GhActionsConfig)
+
+	if from.Permissions != nil {
+		if to.Permissions == nil {
+			//nolint:exhaustruct // No need here, simple init
+			to.Permissions = &GhActionsPermissionsConfig{}
+		}
+
+		to.Permissions.Merge(from.Permissions)
+	}
+}
+
+type GhActionsPermissionsConfig struct {
+	Enabled *string `yaml:"enabled,omitempty"`
+	// AllowedActions is either "all", "local_only" or "selected"
+	AllowedActions *string `yaml:"allowed-actions,omitempty"`
+	// GithubOwnedAllowed, VerifiedAllowed and Patterns are used only if "selected" actions are allowed
+	GithubOwnedAllowed *string   `yaml:"github-owned-allowed,omitempty"`
+	VerifiedAllowed    *string   `yaml:"verified-allowed,omitempty"`
+	Patterns           *[]string `yaml:"patterns,omitempty,flow"`
+	// DefaultWorkflowPermissions is either "read" or "write"
+	DefaultWorkflowPermissions   *string `yaml:"default-workflow-permissions,omitempty"`
+	CanApprovePullRequestReviews *string `yaml:"can-approve-pull-request-reviews,omitempty"`
+}
+
+func (to *GhActionsPermissionsConfig) Merge(from *GhActionsPermissionsConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeStringIfNotNil(&to.Enabled, from.Enabled)
+	mergeStringIfNotNil(&to.AllowedActions, from.AllowedActions)
+	mergeStringIfNotNil(&to.GithubOwnedAllowed, from.GithubOwnedAllowed)
+	mergeStringIfNotNil(&to.VerifiedAllowed, from.VerifiedAllowed)
+	mergeSliceIfNotNil(&to.Patterns, from.Patterns)
+	mergeStringIfNotNil(&to.DefaultWorkflowPermissions, from.DefaultWorkflowPermissions)
+	mergeStringIfNotNil(&to.CanApprovePullRequestReviews, from.CanApprovePullRequestReviews)
+}
+
+type GhActionsConfig struct {
+	Variables *GhActionsVariablesConfig `yaml:"variables,omitempty"`
+	Secrets   *GhActionsSecretsConfig   `yaml:"secrets,omitempty"`
+}
+
+func (to *GhActionsConfig) Merge(from *GhActionsConfig) {
+	if from == nil {
+		return
+	}
+
+	if from.Variables != nil {
+		if to.Variables == nil {
+			to.Variables = &GhActionsVariablesConfig{}
+		}
+
+		to.Variables.Merge(from.Variables)
+	}
+
+	if from.Secrets != nil {
+		if to.Secrets == nil {
+			to.Secrets = &GhActionsSecretsConfig{}
+		}
+
+		to.Secrets.Merge(from.Secrets)
+	}
+}
+
+// GhActionsVariablesConfig contains plaintext values by variable name.
+type GhActionsVariablesConfig map[string]string
+
+func (to *GhActionsVariablesConfig) Merge(from *GhActionsVariablesConfig) {
+	if from == nil {
+		return
+	}
+
+	for name, value := range *from {
+		(*to)[name] = value
+	}
+}
+
+// GhActionsSecretsConfig contains secret configurations by secret name, a nil configuration means default one.
+type GhActionsSecretsConfig map[string]*GhActionsSecretConfig
+
+func (to *GhActionsSecretsConfig) Merge(from *GhActionsSecretsConfig) {
+	if from == nil {
+		return
+	}
+
+	for name, secretConfig := range *from {
+		existingVal, exists := (*to)[name]
+
+		switch {
+		case exists && existingVal != nil:
+			existingVal.Merge(secretConfig)
+		case secretConfig == nil:
+			(*to)[name] = nil
+		default:
+			//nolint:exhaustruct // No need here, it's base structure
+			newVal := &GhActionsSecretConfig{}
+			newVal.Merge(secretConfig)
+			(*to)[name] = newVal
+		}
+	}
+}
+
+// GhActionsSecretConfig describes where the secret value comes from, as it is never part of the configuration.
+type GhActionsSecretConfig struct {
+	// Variable is the name of the terraform input variable providing the value
+	Variable *string `yaml:"variable,omitempty"`
+	// Encrypted means the value provided by the terraform input variable is already encrypted with the public key of
+	// the repository
+	Encrypted *string `yaml:"encrypted,omitempty"`
+}
+
+func (to *GhActionsSecretConfig) Merge(from *GhActionsSecretConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeStringIfNotNil(&to.Variable, from.Variable)
+	mergeStringIfNotNil(&to.Encrypted, from.Encrypted)
+}
@@ -0,0 +1,130 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+func updateGhRepoActionsConfigHelper(c *core.GhRepoActionsConfig, stringToCopy *string, newSliceToCopy *[]string, updatePtr bool) {
+	updateGhActionsConfigHelper(&c.GhActionsConfig, stringToCopy, newSliceToCopy, updatePtr)
+
+	if c.Permissions == nil {
+		c.Permissions = &core.GhActionsPermissionsConfig{}
+	}
+
+	updateStringPtrHelper(&c.Permissions.Enabled, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.Permissions.AllowedActions, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.Permissions.GithubOwnedAllowed, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.Permissions.VerifiedAllowed, stringToCopy, updatePtr)
+	updateSlicePtrHelper(&c.Permissions.Patterns, newSliceToCopy, updatePtr)
+	updateStringPtrHelper(&c.Permissions.DefaultWorkflowPermissions, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.Permissions.CanApprovePullRequestReviews, stringToCopy, updatePtr)
+}
+
+func updateGhActionsConfigHelper(c *core.GhActionsConfig, stringToCopy *string, _ *[]string, updatePtr bool) {
+	if c.Variables == nil || updatePtr {
+		c.Variables = &core.GhActionsVariablesConfig{}
+	}
+
+	(*c.Variables)["A_VARIABLE"] = *stringToCopy
+
+	if c.Secrets == nil {
+		c.Secrets = &core.GhActionsSecretsConfig{}
+	}
+
+	secret, exists := (*c.Secrets)["A_SECRET"]
+	if !exists {
+		secret = &core.GhActionsSecretConfig{}
+		(*c.Secrets)["A_SECRET"] = secret
+	}
+
+	updateStringPtrHelper(&secret.Variable, stringToCopy, updatePtr)
+	updateStringPtrHelper(&secret.Encrypted, stringToCopy, updatePtr)
+}
+
+func TestGhRepoActionsConfig_Merge(t *testing.T) {
+	t.Parallel()
+	// Ensure updating from afterward doesn't affect result of merge and vice versa
+	ensureMergeWithoutOverflowBetweenToAndFrom(
+		t,
+		core.GhRepoActionsConfig{},
+		func(to, from *core.GhRepoActionsConfig) {
+			to.Merge(from)
+		},
+		updateGhRepoActionsConfigHelper,
+	)
+}
+
+func TestGhActionsConfig_Merge(t *testing.T) {
+	t.Parallel()
+	// Ensure updating from afterward doesn't affect result of merge and vice versa
+	ensureMergeWithoutOverflowBetweenToAndFrom(
+		t,
+		core.GhActionsConfig{},
+		func(to, from *core.GhActionsConfig) {
+			to.Merge(from)
+		},
+		updateGhActionsConfigHelper,
+	)
+}
+
+func TestGhActionsSecretsConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	variable1 := "variable1"
+	variable2 := "variable2"
+	encrypted := "true"
+	cases := map[string]struct {
+		value    *core.GhActionsSecretsConfig
+		from     *core.GhActionsSecretsConfig
+		expected *core.GhActionsSecretsConfig
+	}{
+		"full": {
+			&core.GhActionsSecretsConfig{
+				"SECRET1": nil,
+				"SECRET2": {&variable1, nil},
+				"SECRET3": {&variable1, nil},
+			},
+			&core.GhActionsSecretsConfig{
+				"SECRET2": nil,
+				"SECRET3": {&variable2, &encrypted},
+				"SECRET4": nil,
+				"SECRET5": {nil, &encrypted},
+			},
+			&core.GhActionsSecretsConfig{
+				"SECRET1": nil,
+				"SECRET2": {&variable1, nil},
+				"SECRET3": {&variable2, &encrypted},
+				"SECRET4": nil,
+				"SECRET5": {nil, &encrypted},
+			},
+		},
+		"to is empty": {
+			&core.GhActionsSecretsConfig{},
+			&core.GhActionsSecretsConfig{"SECRET1": nil, "SECRET2": {&variable1, &encrypted}},
+			&core.GhActionsSecretsConfig{"SECRET1": nil, "SECRET2": {&variable1, &encrypted}},
+		},
+		"from nil": {
+			&core.GhActionsSecretsConfig{"SECRET1": nil},
+			nil,
+			&core.GhActionsSecretsConfig{"SECRET1": nil},
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+				tc.value.Merge(tc.from)
+
+				if diff := cmp.Diff(tc.expected, tc.value); diff != "" {
+					t.Errorf("Config mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
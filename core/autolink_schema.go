@@ -0,0 +1,41 @@
+package core
+
+// GhRepoAutolinksConfig contains autolink configurations by key prefix.
+type GhRepoAutolinksConfig map[string]*GhRepoAutolinkConfig
+
+func (to *GhRepoAutolinksConfig) Merge(from *GhRepoAutolinksConfig) {
+	if from == nil {
+		return
+	}
+
+	for keyPrefix, autolinkConfig := range *from {
+		existingVal, exists := (*to)[keyPrefix]
+
+		switch {
+		case exists && existingVal != nil:
+			existingVal.Merge(autolinkConfig)
+		case autolinkConfig == nil:
+			(*to)[keyPrefix] = nil
+		default:
+			//nolint:exhaustruct // No need here, it's base structure
+			newVal := &GhRepoAutolinkConfig{}
+			newVal.Merge(autolinkConfig)
+			(*to)[keyPrefix] = newVal
+		}
+	}
+}
+
+type GhRepoAutolinkConfig struct {
+	// UrlTemplate must contain "<num>" placeholder for the reference number
+	UrlTemplate  *string `yaml:"url-template,omitempty"`
+	Alphanumeric *string `yaml:"alphanumeric,omitempty"`
+}
+
+func (to *GhRepoAutolinkConfig) Merge(from *GhRepoAutolinkConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeStringIfNotNil(&to.UrlTemplate, from.UrlTemplate)
+	mergeStringIfNotNil(&to.Alphanumeric, from.Alphanumeric)
+}
@@ -2,6 +2,10 @@ package core
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 )
 
 /** Public **/
@@ -12,6 +16,9 @@ func ComputeConfig(config *Config) (*Config, error) {
 	}
 
 	computedConfig := NewConfig()
+	// No template for organization settings => used as is
+	computedConfig.Org = config.Org
+	computedConfig.CustomProperties = config.CustomProperties
 
 	errList := loadConfig(config, computedConfig)
 
@@ -34,6 +41,30 @@ func loadConfig(config *Config, computedConfig *Config) map[string]error {
 		}
 	}
 
+	// Compute team config
+	if config.Teams != nil {
+		for k, base := range config.Teams {
+			loadConfigTeam(config, computedConfig, base, errList, k)
+		}
+	}
+
+	// Compute organization ruleset config
+	if config.OrgRulesets != nil {
+		for k, base := range config.OrgRulesets {
+			loadConfigOrgRuleset(config, computedConfig, base, errList, k)
+		}
+	}
+
+	// References to other config sections can be checked only once everything is computed
+	ctx := NewWorkspaceContext(computedConfig)
+
+	checkRulesetBypassTeams(computedConfig, ctx, errList)
+	checkEnvironmentReviewerTeams(computedConfig, ctx, errList)
+	checkBranchProtectionBypasserTeams(computedConfig, ctx, errList)
+	checkOrgRulesetRepositories(computedConfig, errList)
+	checkRepositoryFileSources(computedConfig, errList)
+	checkRepositoryCustomProperties(computedConfig, errList)
+
 	return errList
 }
 
@@ -49,3 +80,254 @@ func loadConfigRepo(config *Config, computedConfig *Config, base *GhRepoConfig,
 		}
 	}
 }
+
+func loadConfigTeam(config *Config, computedConfig *Config, base *GhTeamConfig, errList map[string]error, index int) {
+	switch {
+	case base.Name == nil:
+		errList[fmt.Sprintf("Team key %d", index)] = TeamNameIsMandatoryForTeamError(index)
+	case computedConfig.GetTeam(*base.Name) != nil:
+		errList["team "+*base.Name] = TeamAlreadyDeclaredError(*base.Name)
+	default:
+		computedTeam, computeError := ComputeTeamConfig(base, config.Templates)
+		if computeError != nil {
+			errList["team "+*base.Name] = fmt.Errorf("team %s: %w", *base.Name, computeError)
+		} else {
+			computedConfig.AppendTeam(computedTeam)
+		}
+	}
+}
+
+func loadConfigOrgRuleset(
+	config *Config,
+	computedConfig *Config,
+	base *GhOrgRulesetConfig,
+	errList map[string]error,
+	index int,
+) {
+	switch {
+	case base.Name == nil:
+		errList[fmt.Sprintf("Org ruleset key %d", index)] = OrgRulesetNameIsMandatoryError(index)
+	case computedConfig.GetOrgRuleset(*base.Name) != nil:
+		errList["org ruleset "+*base.Name] = OrgRulesetAlreadyDeclaredError(*base.Name)
+	default:
+		computedRuleset, computeError := ApplyRulesetTemplate(&base.GhRulesetConfig, config.Templates)
+		if computeError != nil {
+			errList["org ruleset "+*base.Name] = fmt.Errorf("organization ruleset %s: %w", *base.Name, computeError)
+		} else {
+			computedConfig.AppendOrgRuleset(
+				&GhOrgRulesetConfig{GhRulesetConfig: *computedRuleset, Repositories: base.Repositories},
+			)
+		}
+	}
+}
+
+func checkRulesetBypassTeams(computedConfig *Config, ctx *WorkspaceContext, errList map[string]error) {
+	for _, repo := range computedConfig.Repos {
+		if repo.Rulesets == nil {
+			continue
+		}
+
+		for k, ruleset := range *repo.Rulesets {
+			if unknownTeam := findUnknownRulesetBypassTeam(ruleset, ctx); unknownTeam != nil {
+				errList[fmt.Sprintf("%s ruleset %d", *repo.Name, k)] = fmt.Errorf(
+					"repository %s: %w",
+					*repo.Name,
+					RulesetError(k, UnknownTeamError(*unknownTeam)),
+				)
+			}
+		}
+	}
+
+	for _, ruleset := range computedConfig.OrgRulesets {
+		if unknownTeam := findUnknownRulesetBypassTeam(&ruleset.GhRulesetConfig, ctx); unknownTeam != nil {
+			errList["org ruleset "+*ruleset.Name+" bypass actors"] = fmt.Errorf(
+				"organization ruleset %s: %w",
+				*ruleset.Name,
+				UnknownTeamError(*unknownTeam),
+			)
+		}
+	}
+}
+
+func findUnknownRulesetBypassTeam(ruleset *GhRulesetConfig, ctx *WorkspaceContext) *string {
+	if ruleset.BypassActors == nil {
+		return nil
+	}
+
+	for _, actor := range *ruleset.BypassActors {
+		if actor.Type != nil && *actor.Type == RulesetTeamActorType && actor.Id != nil &&
+			!ctx.IsTeamIdOrDeclaredTeam(*actor.Id) {
+			return actor.Id
+		}
+	}
+
+	return nil
+}
+
+func checkEnvironmentReviewerTeams(computedConfig *Config, ctx *WorkspaceContext, errList map[string]error) {
+	for _, repo := range computedConfig.Repos {
+		if repo.Environments == nil {
+			continue
+		}
+
+		for envName, env := range *repo.Environments {
+			if env.Reviewers == nil || env.Reviewers.Teams == nil {
+				continue
+			}
+
+			for _, team := range *env.Reviewers.Teams {
+				if !ctx.IsTeamIdOrDeclaredTeam(team) {
+					errList[fmt.Sprintf("%s environment %s", *repo.Name, envName)] = fmt.Errorf(
+						"repository %s: %w",
+						*repo.Name,
+						EnvironmentError(envName, UnknownTeamError(team)),
+					)
+
+					break
+				}
+			}
+		}
+	}
+}
+
+func checkBranchProtectionBypasserTeams(computedConfig *Config, ctx *WorkspaceContext, errList map[string]error) {
+	for _, repo := range computedConfig.Repos {
+		if err := findUndeclaredBypasserTeamError(repo, ctx); err != nil {
+			errList[*repo.Name+" branch protection bypassers"] = fmt.Errorf("repository %s: %w", *repo.Name, err)
+		}
+	}
+}
+
+func findUndeclaredBypasserTeamError(repo *GhRepoConfig, ctx *WorkspaceContext) error {
+	if repo.DefaultBranch != nil {
+		if team := findUndeclaredBypasserTeam(repo.DefaultBranch.Protection, ctx); team != nil {
+			return DefaultBranchError(UndeclaredBypasserTeamError(*team))
+		}
+	}
+
+	if repo.Branches != nil {
+		keys, branches := MapToSortedListWithKeys(*repo.Branches)
+		for idx, branch := range branches {
+			if team := findUndeclaredBypasserTeam(branch.Protection, ctx); team != nil {
+				return BranchError(keys[idx], UndeclaredBypasserTeamError(*team))
+			}
+		}
+	}
+
+	if repo.BranchProtections != nil {
+		for k, branchProtection := range *repo.BranchProtections {
+			if team := findUndeclaredBypasserTeam(&branchProtection.BaseGhBranchProtectionConfig, ctx); team != nil {
+				return BranchProtectionError(k, UndeclaredBypasserTeamError(*team))
+			}
+		}
+	}
+
+	return nil
+}
+
+// findUndeclaredBypasserTeam returns the first '@team' bypasser which doesn't refer to a declared team.
+func findUndeclaredBypasserTeam(protection *BaseGhBranchProtectionConfig, ctx *WorkspaceContext) *string {
+	if protection == nil || protection.PullRequestReviews == nil || protection.PullRequestReviews.Bypassers == nil {
+		return nil
+	}
+
+	for _, bypasser := range *protection.PullRequestReviews.Bypassers {
+		if strings.HasPrefix(bypasser, "@") && ctx.BranchProtectionBypasser(bypasser) == bypasser {
+			return &bypasser
+		}
+	}
+
+	return nil
+}
+
+func checkOrgRulesetRepositories(computedConfig *Config, errList map[string]error) {
+	for _, ruleset := range computedConfig.OrgRulesets {
+		if _, err := ResolveOrgRulesetRepositories(ruleset.Repositories, computedConfig.Repos); err != nil {
+			errList["org ruleset "+*ruleset.Name+" repositories"] = fmt.Errorf(
+				"organization ruleset %s: %w",
+				*ruleset.Name,
+				err,
+			)
+		}
+	}
+}
+
+func checkRepositoryFileSources(computedConfig *Config, errList map[string]error) {
+	for _, repo := range computedConfig.Repos {
+		if repo.Files == nil {
+			continue
+		}
+
+		for path, file := range *repo.Files {
+			if err := checkRepositoryFileSource(path, file); err != nil {
+				errList[fmt.Sprintf("%s file %s", *repo.Name, path)] = fmt.Errorf(
+					"repository %s: %w",
+					*repo.Name,
+					RepositoryFileError(path, err),
+				)
+			}
+		}
+	}
+}
+
+func checkRepositoryFileSource(path string, file *GhRepoFileConfig) error {
+	source := MapRepositoryFileSource(path, file)
+
+	sourcePath := source
+	if FilesDirectory != nil {
+		sourcePath = filepath.Join(*FilesDirectory, source)
+	}
+
+	if fs, err := os.Stat(sourcePath); err != nil || fs.IsDir() {
+		return FileSourceNotFoundError(source)
+	}
+
+	return nil
+}
+
+func checkRepositoryCustomProperties(computedConfig *Config, errList map[string]error) {
+	for _, repo := range computedConfig.Repos {
+		if repo.CustomProperties == nil {
+			continue
+		}
+
+		for name, value := range *repo.CustomProperties {
+			if err := checkRepositoryCustomProperty(computedConfig.GetCustomProperty(name), value); err != nil {
+				errList[fmt.Sprintf("%s custom property %s", *repo.Name, name)] = fmt.Errorf(
+					"repository %s: %w",
+					*repo.Name,
+					CustomPropertyError(name, err),
+				)
+			}
+		}
+	}
+}
+
+func checkRepositoryCustomProperty(property *GhCustomPropertyConfig, value *GhCustomPropertyValue) error {
+	if property == nil {
+		return ErrUnknownCustomProperty
+	}
+
+	if value == nil {
+		return nil
+	}
+
+	if *property.ValueType != CustomPropertyMultiSelectType && len(*value) != 1 {
+		return ErrSingleValueIsExpected
+	}
+
+	for _, item := range *value {
+		switch *property.ValueType {
+		case CustomPropertySingleSelectType, CustomPropertyMultiSelectType:
+			if property.AllowedValues == nil || !slices.Contains(*property.AllowedValues, item) {
+				return InvalidPropertyValueError(item)
+			}
+		case CustomPropertyTrueFalseType:
+			if item != "true" && item != "false" {
+				return InvalidPropertyValueError(item)
+			}
+		}
+	}
+
+	return nil
+}
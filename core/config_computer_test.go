@@ -14,6 +14,18 @@ func TestComputeConfig(t *testing.T) {
 	t.Parallel()
 
 	aName := "a_name"
+	bName := "b_name"
+	cName := "c_name"
+	tagTarget := "tag"
+	closedPrivacy := "closed"
+	trueString := "true"
+	falseString := "false"
+	privateVisibility := "private"
+	configuredState := "configured"
+	deployKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMkep8EAvhF//MDLWxu14eveA+xy2SbuAusenR2X7Kfb"
+	teamActorType := core.RulesetTeamActorType
+	singleSelectType := core.CustomPropertySingleSelectType
+	trueFalseType := core.CustomPropertyTrueFalseType
 	cases := map[string]struct {
 		value    *core.Config
 		expected *core.Config
@@ -35,7 +47,7 @@ func TestComputeConfig(t *testing.T) {
 				Repos: []*core.GhRepoConfig{
 					{
 						&aName, nil, nil, nil, nil, nil,
-						nil, nil, nil, nil, nil,
+						nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 					},
 				},
 			},
@@ -44,11 +56,18 @@ func TestComputeConfig(t *testing.T) {
 					Repos:             map[string]*core.GhRepoConfig{},
 					Branches:          map[string]*core.GhBranchConfig{},
 					BranchProtections: map[string]*core.GhBranchProtectionConfig{},
+					Teams:             map[string]*core.GhTeamConfig{},
+					Rulesets:          map[string]*core.GhRulesetConfig{},
+					Environments:      map[string]*core.GhEnvironmentConfig{},
+					Webhooks:          map[string]*core.GhWebhookConfig{},
+					Labels:            map[string]*core.GhLabelsConfig{},
 				},
+				Teams:       []*core.GhTeamConfig{},
+				OrgRulesets: []*core.GhOrgRulesetConfig{},
 				Repos: []*core.GhRepoConfig{
 					{
 						&aName, nil, nil, nil, nil, nil,
-						nil, nil, nil, nil, nil,
+						nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 					},
 				},
 			},
@@ -60,11 +79,11 @@ func TestComputeConfig(t *testing.T) {
 				Repos: []*core.GhRepoConfig{
 					{
 						nil, nil, nil, nil, nil, nil,
-						nil, nil, nil, nil, nil,
+						nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 					},
 					{
 						nil, nil, nil, nil, nil, nil,
-						nil, nil, nil, nil, nil,
+						nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 					},
 				},
 			},
@@ -77,13 +96,281 @@ func TestComputeConfig(t *testing.T) {
 				Repos: []*core.GhRepoConfig{
 					{
 						&aName, &[]string{aName}, nil, nil, nil, nil,
-						nil, nil, nil, nil, nil,
+						nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 					},
 				},
 			},
 			nil,
 			errors.New("error during computation:\n\t - repository a_name: repository template not found as none available"),
 		},
+		"Ruleset with unknown bypass team": {
+			&core.Config{
+				Templates: nil,
+				Repos: []*core.GhRepoConfig{
+					{
+						&aName, nil, nil, nil, nil, nil,
+						nil, nil, nil, nil, nil, nil, nil,
+						&core.GhRulesetsConfig{
+							{
+								Name: &bName,
+								BypassActors: &core.GhRulesetBypassActorsConfig{
+									{Type: &teamActorType, Id: &bName, Mode: nil},
+								},
+							},
+						},
+						nil, nil, nil, nil, nil, nil, nil, nil,
+					},
+				},
+			},
+			nil,
+			errors.New("error during computation:\n\t - repository a_name: ruleset #0: team b_name: neither a declared team nor a team ID"),
+		},
+		"Environment with unknown reviewer team": {
+			&core.Config{
+				Templates: nil,
+				Repos: []*core.GhRepoConfig{
+					{
+						&aName, nil, nil, nil, nil, nil,
+						nil, nil, nil, nil, nil, nil, nil, nil,
+						&core.GhEnvironmentsConfig{
+							"production": {
+								Reviewers: &core.GhEnvironmentReviewersConfig{Teams: &[]string{bName}},
+							},
+						},
+						nil, nil, nil, nil, nil, nil, nil,
+					},
+				},
+			},
+			nil,
+			errors.New("error during computation:\n\t - repository a_name: environment production: team b_name: neither a declared team nor a team ID"),
+		},
+		"Branch protection with undeclared bypasser team": {
+			&core.Config{
+				Repos: []*core.GhRepoConfig{
+					{
+						Name: &aName,
+						DefaultBranch: &core.GhDefaultBranchConfig{
+							Name: &bName,
+							BaseGhBranchConfig: core.BaseGhBranchConfig{
+								Protection: &core.BaseGhBranchProtectionConfig{
+									PullRequestReviews: &core.GhBranchProtectPRReviewConfig{
+										Bypassers: &[]string{"a-user", "an-org/a-team", "@" + cName},
+									},
+								},
+							},
+						},
+					},
+					{
+						Name: &bName,
+						BranchProtections: &core.GhBranchProtectionsConfig{
+							{
+								Pattern: &bName,
+								BaseGhBranchProtectionConfig: core.BaseGhBranchProtectionConfig{
+									PullRequestReviews: &core.GhBranchProtectPRReviewConfig{
+										Bypassers: &[]string{"@" + aName, "@" + cName},
+									},
+								},
+							},
+						},
+					},
+				},
+				Teams: []*core.GhTeamConfig{{Name: &aName, Privacy: &closedPrivacy}},
+			},
+			nil,
+			errors.New("error during computation:\n\t - repository a_name: default branch: bypasser @c_name: team not declared\n\t - repository b_name: branch protection #0: bypasser @c_name: team not declared"),
+		},
+		"Org ruleset with template": {
+			&core.Config{
+				Templates: &core.TemplatesConfig{
+					Rulesets: map[string]*core.GhRulesetConfig{
+						"a-ruleset-template": {Target: &tagTarget},
+					},
+				},
+				Repos: []*core.GhRepoConfig{
+					{
+						&aName, nil, nil, nil, nil, nil,
+						nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+					},
+				},
+				OrgRulesets: []*core.GhOrgRulesetConfig{
+					{
+						GhRulesetConfig: core.GhRulesetConfig{Name: &bName, ConfigTemplates: &[]string{"a-ruleset-template"}},
+						Repositories:    &core.GhOrgRulesetRepositoriesConfig{Names: &[]string{aName}},
+					},
+				},
+			},
+			&core.Config{
+				Templates: &core.TemplatesConfig{
+					Repos:             map[string]*core.GhRepoConfig{},
+					Branches:          map[string]*core.GhBranchConfig{},
+					BranchProtections: map[string]*core.GhBranchProtectionConfig{},
+					Teams:             map[string]*core.GhTeamConfig{},
+					Rulesets:          map[string]*core.GhRulesetConfig{},
+					Environments:      map[string]*core.GhEnvironmentConfig{},
+					Webhooks:          map[string]*core.GhWebhookConfig{},
+					Labels:            map[string]*core.GhLabelsConfig{},
+				},
+				Teams: []*core.GhTeamConfig{},
+				Repos: []*core.GhRepoConfig{
+					{
+						&aName, nil, nil, nil, nil, nil,
+						nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+					},
+				},
+				OrgRulesets: []*core.GhOrgRulesetConfig{
+					{
+						GhRulesetConfig: core.GhRulesetConfig{Name: &bName, Target: &tagTarget},
+						Repositories:    &core.GhOrgRulesetRepositoriesConfig{Names: &[]string{aName}},
+					},
+				},
+			},
+			nil,
+		},
+		"Org ruleset errors": {
+			&core.Config{
+				Templates: nil,
+				OrgRulesets: []*core.GhOrgRulesetConfig{
+					{},
+					{GhRulesetConfig: core.GhRulesetConfig{Name: &aName, ConfigTemplates: &[]string{aName}}},
+					{
+						GhRulesetConfig: core.GhRulesetConfig{Name: &bName},
+						Repositories:    &core.GhOrgRulesetRepositoriesConfig{Names: &[]string{"unknown-repo"}},
+					},
+					{GhRulesetConfig: core.GhRulesetConfig{Name: &bName}},
+					{
+						GhRulesetConfig: core.GhRulesetConfig{
+							Name: &cName,
+							BypassActors: &core.GhRulesetBypassActorsConfig{
+								{Type: &teamActorType, Id: &bName, Mode: nil},
+							},
+						},
+						Repositories: &core.GhOrgRulesetRepositoriesConfig{Topics: &[]string{"unknown-topic"}},
+					},
+				},
+			},
+			nil,
+			errors.New("error during computation:\n\t - organization ruleset #0: ruleset name is mandatory\n\t - organization ruleset a_name: ruleset template not found as none available\n\t - organization ruleset b_name: organization ruleset already declared\n\t - organization ruleset b_name: repository unknown-repo: repository not declared\n\t - organization ruleset c_name: team b_name: neither a declared team nor a team ID\n\t - organization ruleset c_name: topic unknown-topic: no declared repository with this topic"),
+		},
+		"Team with template": {
+			&core.Config{
+				Templates: &core.TemplatesConfig{
+					Teams: map[string]*core.GhTeamConfig{
+						"a-team-template": {nil, nil, nil, &closedPrivacy, nil, nil, &[]string{"a-member"}},
+					},
+				},
+				Teams: []*core.GhTeamConfig{
+					{&aName, &[]string{"a-team-template"}, nil, nil, nil, nil, &[]string{"another-member"}},
+				},
+			},
+			&core.Config{
+				Templates: &core.TemplatesConfig{
+					Repos:             map[string]*core.GhRepoConfig{},
+					Branches:          map[string]*core.GhBranchConfig{},
+					BranchProtections: map[string]*core.GhBranchProtectionConfig{},
+					Teams:             map[string]*core.GhTeamConfig{},
+					Rulesets:          map[string]*core.GhRulesetConfig{},
+					Environments:      map[string]*core.GhEnvironmentConfig{},
+					Webhooks:          map[string]*core.GhWebhookConfig{},
+					Labels:            map[string]*core.GhLabelsConfig{},
+				},
+				Repos: []*core.GhRepoConfig{},
+				Teams: []*core.GhTeamConfig{
+					{&aName, nil, nil, &closedPrivacy, nil, nil, &[]string{"a-member", "another-member"}},
+				},
+				OrgRulesets: []*core.GhOrgRulesetConfig{},
+			},
+			nil,
+		},
+		"Team errors": {
+			&core.Config{
+				Templates: nil,
+				Teams: []*core.GhTeamConfig{
+					{nil, nil, nil, nil, nil, nil, nil},
+					{&aName, &[]string{aName}, nil, nil, nil, nil, nil},
+					{&bName, nil, nil, nil, nil, nil, nil},
+					{&bName, nil, nil, nil, nil, nil, nil},
+				},
+			},
+			nil,
+			errors.New("error during computation:\n\t - team #0: team name is mandatory\n\t - team a_name: team template not found as none available\n\t - team b_name: team already declared"),
+		},
+		"Repository security errors": {
+			&core.Config{
+				Templates: &core.TemplatesConfig{
+					Repos: map[string]*core.GhRepoConfig{
+						"push-protection": {Security: &core.GhRepoSecurityConfig{SecretScanningPushProtection: &trueString}},
+					},
+				},
+				Repos: []*core.GhRepoConfig{
+					{
+						Name:            &aName,
+						ConfigTemplates: &[]string{"push-protection"},
+						Security:        &core.GhRepoSecurityConfig{SecretScanning: &falseString},
+					},
+					{
+						Name:       &bName,
+						Visibility: &privateVisibility,
+						Security: &core.GhRepoSecurityConfig{
+							CodeScanning: &core.GhRepoCodeScanningConfig{State: &configuredState},
+						},
+					},
+					{
+						Name: &cName,
+						Security: &core.GhRepoSecurityConfig{
+							CodeScanning: &core.GhRepoCodeScanningConfig{State: &configuredState},
+						},
+					},
+				},
+			},
+			nil,
+			errors.New("error during computation:\n\t - repository a_name: security: secret scanning is mandatory for push protection\n\t - repository b_name: security: advanced security is mandatory for code scanning on non-public repository"),
+		},
+		"Repository deploy key errors": {
+			&core.Config{
+				Templates: &core.TemplatesConfig{
+					Repos: map[string]*core.GhRepoConfig{
+						"deploy-key": {DeployKeys: &core.GhRepoDeployKeysConfig{{Title: &bName, Key: &deployKey}}},
+					},
+				},
+				Repos: []*core.GhRepoConfig{
+					{
+						Name:            &aName,
+						ConfigTemplates: &[]string{"deploy-key"},
+						DeployKeys:      &core.GhRepoDeployKeysConfig{{Title: &bName, Key: &deployKey}},
+					},
+				},
+			},
+			nil,
+			errors.New("error during computation:\n\t - repository a_name: deploy key #1: deploy key already declared"),
+		},
+		"Repository custom property and autolink errors": {
+			&core.Config{
+				CustomProperties: []*core.GhCustomPropertyConfig{
+					{Name: &aName, ValueType: &singleSelectType, AllowedValues: &[]string{"value1", "value2"}},
+					{Name: &bName, ValueType: &trueFalseType},
+				},
+				Repos: []*core.GhRepoConfig{
+					{
+						Name: &aName,
+						CustomProperties: &core.GhRepoCustomPropertiesConfig{
+							aName: {"value3"},
+							bName: {"yes"},
+							cName: {"value"},
+						},
+					},
+					{
+						Name:             &bName,
+						CustomProperties: &core.GhRepoCustomPropertiesConfig{aName: {"value1", "value2"}},
+					},
+					{
+						Name:      &cName,
+						Autolinks: &core.GhRepoAutolinksConfig{"TICKET-": {Alphanumeric: &trueString}},
+					},
+				},
+			},
+			nil,
+			errors.New("error during computation:\n\t - repository a_name: custom property a_name: value not allowed: value3\n\t - repository a_name: custom property b_name: value not allowed: yes\n\t - repository a_name: custom property c_name: custom property not defined\n\t - repository b_name: custom property a_name: a single value is expected\n\t - repository c_name: autolink TICKET-: url template is mandatory"),
+		},
 	}
 
 	for tcname, tc := range cases {
@@ -108,3 +395,65 @@ func TestComputeConfig(t *testing.T) {
 		)
 	}
 }
+
+//nolint:paralleltest // Can't be done on parallel as core.FilesDirectory is used (else race condition)
+func TestComputeConfig_repositoryFiles(t *testing.T) {
+	aName := "a_name"
+	filesDir := "testdata/files"
+	securitySource := "SECURITY.md"
+	unknownSource := "unknown.md"
+	dirSource := "docs"
+	cases := map[string]struct {
+		filesDirectory *string
+		files          *core.GhRepoFilesConfig
+		error          error
+	}{
+		"Existing source": {
+			&filesDir,
+			&core.GhRepoFilesConfig{"SECURITY.md": nil, ".github/SECURITY.md": {Source: &securitySource}},
+			nil,
+		},
+		"No files directory": {
+			nil,
+			&core.GhRepoFilesConfig{"testdata/files/SECURITY.md": nil},
+			nil,
+		},
+		"No files directory and unknown source": {
+			nil,
+			&core.GhRepoFilesConfig{"SECURITY.md": nil},
+			errors.New("error during computation:\n\t - repository a_name: repository file SECURITY.md: source file not found: SECURITY.md"),
+		},
+		"Unknown source": {
+			&filesDir,
+			&core.GhRepoFilesConfig{"SECURITY.md": {Source: &unknownSource}},
+			errors.New("error during computation:\n\t - repository a_name: repository file SECURITY.md: source file not found: unknown.md"),
+		},
+		"Directory source": {
+			&filesDir,
+			&core.GhRepoFilesConfig{"docs": {Source: &dirSource}},
+			errors.New("error during computation:\n\t - repository a_name: repository file docs: source file not found: docs"),
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				core.FilesDirectory = tc.filesDirectory
+
+				_, err := core.ComputeConfig(&core.Config{Repos: []*core.GhRepoConfig{{Name: &aName, Files: tc.files}}})
+				if tc.error != nil {
+					if err == nil {
+						t.Errorf("Case %q: expected an error but everything went well", tcname)
+					} else if err.Error() != tc.error.Error() {
+						t.Errorf("Case %q:\n- expected\n+ actual\n\n%v", tcname, differ.LineDiff(tc.error.Error(), err.Error()))
+					}
+				} else if err != nil {
+					t.Errorf("Case %q: %s", tcname, err)
+				}
+			},
+		)
+	}
+
+	core.FilesDirectory = nil
+}
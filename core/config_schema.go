@@ -6,17 +6,26 @@ func NewConfig() *Config {
 			Repos:             map[string]*GhRepoConfig{},
 			Branches:          map[string]*GhBranchConfig{},
 			BranchProtections: map[string]*GhBranchProtectionConfig{},
+			Teams:             map[string]*GhTeamConfig{},
+			Rulesets:          map[string]*GhRulesetConfig{},
+			Environments:      map[string]*GhEnvironmentConfig{},
+			Webhooks:          map[string]*GhWebhookConfig{},
+			Labels:            map[string]*GhLabelsConfig{},
 		},
-		Repos: []*GhRepoConfig{},
+		Repos:       []*GhRepoConfig{},
+		Teams:       []*GhTeamConfig{},
+		OrgRulesets: []*GhOrgRulesetConfig{},
 	}
 }
 
 type Config struct {
-	Templates *TemplatesConfig `yaml:"templates,omitempty"`
-	Repos     []*GhRepoConfig  `yaml:"repos,omitempty"`
-
-	// Org ...
-	// Teams ...
+	Templates   *TemplatesConfig      `yaml:"templates,omitempty"`
+	Repos       []*GhRepoConfig       `yaml:"repos,omitempty"`
+	Teams       []*GhTeamConfig       `yaml:"teams,omitempty"`
+	Org         *GhOrgConfig          `yaml:"org,omitempty"`
+	OrgRulesets []*GhOrgRulesetConfig `yaml:"org-rulesets,omitempty"`
+	// CustomProperties contains organization custom property definitions, repository values are validated against
+	CustomProperties []*GhCustomPropertyConfig `yaml:"custom-properties,omitempty"`
 }
 
 func (c *Config) AppendRepo(repo *GhRepoConfig) {
@@ -33,10 +42,57 @@ func (c *Config) GetRepo(name string) *GhRepoConfig {
 	return nil
 }
 
+func (c *Config) AppendTeam(team *GhTeamConfig) {
+	c.Teams = append(c.Teams, team)
+}
+
+func (c *Config) GetTeam(name string) *GhTeamConfig {
+	for _, t := range c.Teams {
+		if t.Name != nil && *t.Name == name {
+			return t
+		}
+	}
+
+	return nil
+}
+
+func (c *Config) AppendOrgRuleset(ruleset *GhOrgRulesetConfig) {
+	c.OrgRulesets = append(c.OrgRulesets, ruleset)
+}
+
+func (c *Config) GetOrgRuleset(name string) *GhOrgRulesetConfig {
+	for _, r := range c.OrgRulesets {
+		if r.Name != nil && *r.Name == name {
+			return r
+		}
+	}
+
+	return nil
+}
+
+func (c *Config) AppendCustomProperty(property *GhCustomPropertyConfig) {
+	c.CustomProperties = append(c.CustomProperties, property)
+}
+
+func (c *Config) GetCustomProperty(name string) *GhCustomPropertyConfig {
+	for _, p := range c.CustomProperties {
+		if p.Name != nil && *p.Name == name {
+			return p
+		}
+	}
+
+	return nil
+}
+
 type TemplatesConfig struct {
 	Repos             map[string]*GhRepoConfig             `yaml:"repos,omitempty"`
 	Branches          map[string]*GhBranchConfig           `yaml:"branches,omitempty"`
 	BranchProtections map[string]*GhBranchProtectionConfig `yaml:"branch-protections,omitempty"`
+	Teams             map[string]*GhTeamConfig             `yaml:"teams,omitempty"`
+	Rulesets          map[string]*GhRulesetConfig          `yaml:"rulesets,omitempty"`
+	Environments      map[string]*GhEnvironmentConfig      `yaml:"environments,omitempty"`
+	Webhooks          map[string]*GhWebhookConfig          `yaml:"webhooks,omitempty"`
+	Labels            map[string]*GhLabelsConfig           `yaml:"labels,omitempty"`
 }
 
 func (c *TemplatesConfig) GetRepo(name string) *GhRepoConfig {
@@ -74,3 +130,63 @@ func (c *TemplatesConfig) GetBranchProtection(name string) *GhBranchProtectionCo
 
 	return nil
 }
+
+func (c *TemplatesConfig) GetTeam(name string) *GhTeamConfig {
+	if c.Teams == nil {
+		return nil
+	}
+
+	if tpl, ok := c.Teams[name]; ok {
+		return tpl
+	}
+
+	return nil
+}
+
+func (c *TemplatesConfig) GetRuleset(name string) *GhRulesetConfig {
+	if c.Rulesets == nil {
+		return nil
+	}
+
+	if tpl, ok := c.Rulesets[name]; ok {
+		return tpl
+	}
+
+	return nil
+}
+
+func (c *TemplatesConfig) GetEnvironment(name string) *GhEnvironmentConfig {
+	if c.Environments == nil {
+		return nil
+	}
+
+	if tpl, ok := c.Environments[name]; ok {
+		return tpl
+	}
+
+	return nil
+}
+
+func (c *TemplatesConfig) GetWebhook(name string) *GhWebhookConfig {
+	if c.Webhooks == nil {
+		return nil
+	}
+
+	if tpl, ok := c.Webhooks[name]; ok {
+		return tpl
+	}
+
+	return nil
+}
+
+func (c *TemplatesConfig) GetLabels(name string) *GhLabelsConfig {
+	if c.Labels == nil {
+		return nil
+	}
+
+	if tpl, ok := c.Labels[name]; ok {
+		return tpl
+	}
+
+	return nil
+}
@@ -176,3 +176,87 @@ func TestTemplatesConfig_GetBranchProtection(t *testing.T) {
 		)
 	}
 }
+
+func TestConfig_GetTeam(t *testing.T) {
+	t.Parallel()
+
+	knownTeamName := "known-team"
+	unknownCase := core.NewConfig()
+	knownCase := core.NewConfig()
+	knownTeam := core.GhTeamConfig{Name: &knownTeamName}
+	knownCase.AppendTeam(&knownTeam)
+	cases := map[string]struct {
+		value    *core.Config
+		name     string
+		expected *core.GhTeamConfig
+	}{
+		"unknown": {
+			unknownCase,
+			"unknown-team",
+			nil,
+		},
+		"known": {
+			knownCase,
+			knownTeamName,
+			&knownTeam,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				actual := tc.value.GetTeam(tc.name)
+				if diff := cmp.Diff(tc.expected, actual); diff != "" {
+					t.Errorf("Config mismatch (-want +got):\n%s", diff)
+				} else if fmt.Sprintf("%p", tc.expected) != fmt.Sprintf("%p", actual) {
+					t.Errorf("Config mismatch want pointer to %p, got pointer to %p", tc.expected, actual)
+				}
+			},
+		)
+	}
+}
+
+func TestTemplatesConfig_GetTeam(t *testing.T) {
+	t.Parallel()
+
+	knownTemplateName := "known-template"
+	unknownCase := core.NewConfig().Templates
+	knownCase := core.NewConfig().Templates
+	knownTemplate := core.GhTeamConfig{}
+	knownCase.Teams[knownTemplateName] = &knownTemplate
+	cases := map[string]struct {
+		value    *core.TemplatesConfig
+		name     string
+		expected *core.GhTeamConfig
+	}{
+		"unknown": {
+			unknownCase,
+			"unknown-template",
+			nil,
+		},
+		"known": {
+			knownCase,
+			knownTemplateName,
+			&knownTemplate,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				actual := tc.value.GetTeam(tc.name)
+				if diff := cmp.Diff(tc.expected, actual); diff != "" {
+					t.Errorf("Config mismatch (-want +got):\n%s", diff)
+				} else if fmt.Sprintf("%p", tc.expected) != fmt.Sprintf("%p", actual) {
+					t.Errorf("Config mismatch want pointer to %p, got pointer to %p", tc.expected, actual)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,67 @@
+package core
+
+const (
+	CustomPropertyStringType       = "string"
+	CustomPropertySingleSelectType = "single_select"
+	CustomPropertyMultiSelectType  = "multi_select"
+	CustomPropertyTrueFalseType    = "true_false"
+)
+
+// GhCustomPropertyConfig contains an organization custom property definition, used to validate repository values.
+type GhCustomPropertyConfig struct {
+	Name          *string   `yaml:"name,omitempty"`
+	ValueType     *string   `yaml:"value-type,omitempty"`
+	AllowedValues *[]string `yaml:"allowed-values,omitempty,flow"`
+}
+
+// GhRepoCustomPropertiesConfig contains custom property values by property name, a nil value unsets the value
+// provided by templates.
+type GhRepoCustomPropertiesConfig map[string]*GhCustomPropertyValue
+
+func (to *GhRepoCustomPropertiesConfig) Merge(from *GhRepoCustomPropertiesConfig) {
+	if from == nil {
+		return
+	}
+
+	for name, value := range *from {
+		if value == nil {
+			(*to)[name] = nil
+
+			continue
+		}
+
+		// Values are overridden, not appended
+		newValue := make(GhCustomPropertyValue, len(*value))
+		copy(newValue, *value)
+		(*to)[name] = &newValue
+	}
+}
+
+// GhCustomPropertyValue is either a single value, or a list of values for multi select properties.
+type GhCustomPropertyValue []string
+
+func (v *GhCustomPropertyValue) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []string
+	if err := unmarshal(&list); err == nil {
+		*v = list
+
+		return nil
+	}
+
+	var value string
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+
+	*v = GhCustomPropertyValue{value}
+
+	return nil
+}
+
+func (v GhCustomPropertyValue) MarshalYAML() (interface{}, error) {
+	if len(v) == 1 {
+		return v[0], nil
+	}
+
+	return []string(v), nil
+}
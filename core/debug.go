@@ -6,7 +6,7 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-func ConfigTrace[T GhRepoConfig | Config](header string, c *T) {
+func ConfigTrace[T GhRepoConfig | GhTeamConfig | Config](header string, c *T) {
 	if zerolog.GlobalLevel() == zerolog.TraceLevel {
 		encoded, encodeError := yaml.Marshal(*c)
 		if encodeError == nil {
@@ -0,0 +1,44 @@
+package core
+
+// WorkspaceDirectory is the directory deploy key files are relative to, current directory is used if nil.
+//
+//nolint:gochecknoglobals //Easier to manage it as exported variable
+var WorkspaceDirectory *string
+
+type GhRepoDeployKeysConfig []*GhRepoDeployKeyConfig
+
+func (to *GhRepoDeployKeysConfig) Merge(from *GhRepoDeployKeysConfig) {
+	if from == nil {
+		return
+	}
+	// Duplicate every 'from' items to avoid overflow later
+	newItems := make(GhRepoDeployKeysConfig, len(*from))
+
+	for k, v := range *from {
+		//nolint:exhaustruct // No need here, it's base structure
+		newItem := &GhRepoDeployKeyConfig{}
+		newItem.Merge(v)
+		newItems[k] = newItem
+	}
+
+	*to = append(*to, newItems...)
+}
+
+type GhRepoDeployKeyConfig struct {
+	Title    *string `yaml:"title,omitempty"`
+	ReadOnly *string `yaml:"read-only,omitempty"`
+	// KeyFile is the path of the public key file, relative to the workspace. It overrides Key at computation time
+	KeyFile *string `yaml:"key-file,omitempty"`
+	Key     *string `yaml:"key,omitempty"`
+}
+
+func (to *GhRepoDeployKeyConfig) Merge(from *GhRepoDeployKeyConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeStringIfNotNil(&to.Title, from.Title)
+	mergeStringIfNotNil(&to.ReadOnly, from.ReadOnly)
+	mergeStringIfNotNil(&to.KeyFile, from.KeyFile)
+	mergeStringIfNotNil(&to.Key, from.Key)
+}
@@ -0,0 +1,96 @@
+package core
+
+type GhEnvironmentsConfig map[string]*GhEnvironmentConfig
+
+func (to *GhEnvironmentsConfig) Merge(from *GhEnvironmentsConfig) {
+	if from == nil {
+		return
+	}
+
+	for envName, envConfig := range *from {
+		existingVal, exists := (*to)[envName]
+		if exists {
+			existingVal.Merge(envConfig)
+		} else {
+			//nolint:exhaustruct // No need here, it's base structure
+			newVal := &GhEnvironmentConfig{}
+			newVal.Merge(envConfig)
+			(*to)[envName] = newVal
+		}
+	}
+}
+
+type GhEnvironmentConfig struct {
+	//nolint:tagliatelle // yaml templates, not config templates => better to use underscore here
+	ConfigTemplates    *[]string                              `yaml:"_templates,omitempty,flow"`
+	WaitTimer          *string                                `yaml:"wait-timer,omitempty"`
+	Reviewers          *GhEnvironmentReviewersConfig          `yaml:"reviewers,omitempty"`
+	PreventSelfReview  *string                                `yaml:"prevent-self-review,omitempty"`
+	DeploymentBranches *GhEnvironmentDeploymentBranchesConfig `yaml:"deployment-branches,omitempty"`
+	Actions            *GhActionsConfig                       `yaml:"actions,omitempty"`
+}
+
+func (to *GhEnvironmentConfig) Merge(from *GhEnvironmentConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeSliceIfNotNil(&to.ConfigTemplates, from.ConfigTemplates)
+	mergeStringIfNotNil(&to.WaitTimer, from.WaitTimer)
+	mergeStringIfNotNil(&to.PreventSelfReview, from.PreventSelfReview)
+
+	if from.Reviewers != nil {
+		if to.Reviewers == nil {
+			//nolint:exhaustruct // No need here, simple init
+			to.Reviewers = &GhEnvironmentReviewersConfig{}
+		}
+
+		to.Reviewers.Merge(from.Reviewers)
+	}
+
+	if from.DeploymentBranches != nil {
+		if to.DeploymentBranches == nil {
+			//nolint:exhaustruct // No need here, simple init
+			to.DeploymentBranches = &GhEnvironmentDeploymentBranchesConfig{}
+		}
+
+		to.DeploymentBranches.Merge(from.DeploymentBranches)
+	}
+
+	if from.Actions != nil {
+		if to.Actions == nil {
+			//nolint:exhaustruct // No need here, simple init
+			to.Actions = &GhActionsConfig{}
+		}
+
+		to.Actions.Merge(from.Actions)
+	}
+}
+
+type GhEnvironmentReviewersConfig struct {
+	Users *[]string `yaml:"users,omitempty,flow"`
+	Teams *[]string `yaml:"teams,omitempty,flow"`
+}
+
+func (to *GhEnvironmentReviewersConfig) Merge(from *GhEnvironmentReviewersConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeSliceIfNotNil(&to.Users, from.Users)
+	mergeSliceIfNotNil(&to.Teams, from.Teams)
+}
+
+type GhEnvironmentDeploymentBranchesConfig struct {
+	ProtectedOnly *string   `yaml:"protected-only,omitempty"`
+	Patterns      *[]string `yaml:"patterns,omitempty,flow"`
+}
+
+func (to *GhEnvironmentDeploymentBranchesConfig) Merge(from *GhEnvironmentDeploymentBranchesConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeStringIfNotNil(&to.ProtectedOnly, from.ProtectedOnly)
+	mergeSliceIfNotNil(&to.Patterns, from.Patterns)
+}
@@ -0,0 +1,125 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+func updateGhEnvironmentConfigHelper(c *core.GhEnvironmentConfig, stringToCopy *string, newSliceToCopy *[]string, updatePtr bool) {
+	updateSlicePtrHelper(&c.ConfigTemplates, newSliceToCopy, updatePtr)
+	updateStringPtrHelper(&c.WaitTimer, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.PreventSelfReview, stringToCopy, updatePtr)
+
+	if c.Reviewers == nil {
+		c.Reviewers = &core.GhEnvironmentReviewersConfig{}
+	}
+
+	updateSlicePtrHelper(&c.Reviewers.Users, newSliceToCopy, updatePtr)
+	updateSlicePtrHelper(&c.Reviewers.Teams, newSliceToCopy, updatePtr)
+
+	if c.DeploymentBranches == nil {
+		c.DeploymentBranches = &core.GhEnvironmentDeploymentBranchesConfig{}
+	}
+
+	updateStringPtrHelper(&c.DeploymentBranches.ProtectedOnly, stringToCopy, updatePtr)
+	updateSlicePtrHelper(&c.DeploymentBranches.Patterns, newSliceToCopy, updatePtr)
+
+	if c.Actions == nil {
+		c.Actions = &core.GhActionsConfig{}
+	}
+
+	updateGhActionsConfigHelper(c.Actions, stringToCopy, newSliceToCopy, updatePtr)
+}
+
+func TestGhEnvironmentConfig_Merge(t *testing.T) {
+	t.Parallel()
+	// Ensure updating from afterward doesn't affect result of merge and vice versa
+	ensureMergeWithoutOverflowBetweenToAndFrom(
+		t,
+		core.GhEnvironmentConfig{},
+		func(to, from *core.GhEnvironmentConfig) {
+			to.Merge(from)
+		},
+		updateGhEnvironmentConfigHelper,
+	)
+}
+
+func TestGhEnvironmentConfig_Merge_2(t *testing.T) {
+	t.Parallel()
+
+	full1 := (*GetFullConfig(1).Environments)["environment1"]
+	full2 := (*GetFullConfig(2).Environments)["environment2"]
+	// manually generate result of full2 into full1
+	fullMergeResult := (*GetFullConfig(2).Environments)["environment2"]
+	*fullMergeResult.ConfigTemplates = append(*(full1.ConfigTemplates), *(full2.ConfigTemplates)...)
+	*fullMergeResult.Reviewers.Users = append(*(full1.Reviewers.Users), *(full2.Reviewers.Users)...)
+	*fullMergeResult.Reviewers.Teams = append(*(full1.Reviewers.Teams), *(full2.Reviewers.Teams)...)
+	*fullMergeResult.DeploymentBranches.Patterns = append(
+		*(full1.DeploymentBranches.Patterns),
+		*(full2.DeploymentBranches.Patterns)...,
+	)
+
+	cases := map[string]struct {
+		value    *core.GhEnvironmentConfig
+		from     *core.GhEnvironmentConfig
+		expected *core.GhEnvironmentConfig
+	}{
+		"full": {
+			full1,
+			full2,
+			fullMergeResult,
+		},
+		"to is empty": {
+			&core.GhEnvironmentConfig{},
+			(*GetFullConfig(1).Environments)["environment1"],
+			(*GetFullConfig(1).Environments)["environment1"],
+		},
+		"from nil": {
+			(*GetFullConfig(1).Environments)["environment1"],
+			nil,
+			(*GetFullConfig(1).Environments)["environment1"],
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+				tc.value.Merge(tc.from)
+
+				if diff := cmp.Diff(tc.expected, tc.value); diff != "" {
+					t.Errorf("Config mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
+
+func TestGhEnvironmentsConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	from := GetFullConfig(1).Environments
+	to := GetFullConfig(2).Environments
+	expected := GetFullConfig(2).Environments
+
+	for name, envConfig := range *GetFullConfig(1).Environments {
+		(*expected)[name] = envConfig
+	}
+
+	to.Merge(from)
+
+	if diff := cmp.Diff(expected, to); diff != "" {
+		t.Errorf("Config mismatch (-want +got):\n%s", diff)
+	}
+
+	// Ensure items have been copied
+	*(*from)["environment1"].WaitTimer = "updated"
+
+	if diff := cmp.Diff(expected, to); diff != "" {
+		t.Errorf("Config mismatch after 'from' update (-want +got):\n%s", diff)
+	}
+}
@@ -8,6 +8,30 @@ import (
 
 var (
 	ErrRepositoryNameIsMandatory = errors.New("repository name is mandatory")
+	ErrTeamNameIsMandatory       = errors.New("team name is mandatory")
+	ErrTeamAlreadyDeclared       = errors.New("team already declared")
+	ErrUnknownTeam               = errors.New("neither a declared team nor a team ID")
+	ErrRulesetNameIsMandatory    = errors.New("ruleset name is mandatory")
+	ErrWebhookUrlIsMandatory     = errors.New("webhook url is mandatory")
+	ErrWebhookAlreadyDeclared    = errors.New("webhook identifier already used by another webhook")
+	ErrLabelAlreadyDeclared      = errors.New("label already declared (names are case insensitive)")
+	ErrLabelColorIsMandatory     = errors.New("label color is mandatory")
+	ErrOrgRulesetAlreadyDeclared = errors.New("organization ruleset already declared")
+	ErrUnknownRepository         = errors.New("repository not declared")
+	ErrUnknownRepositoryTopic    = errors.New("no declared repository with this topic")
+	ErrFileSourceNotFound        = errors.New("source file not found")
+	ErrSecretScanningIsMandatory = errors.New("secret scanning is mandatory for push protection")
+	ErrAdvancedSecurityMandatory = errors.New("advanced security is mandatory for code scanning on non-public repository")
+	ErrDeployKeyFileNotFound     = errors.New("deploy key file not found")
+	ErrInvalidOpenSshPublicKey   = errors.New("invalid OpenSSH public key")
+	ErrDeployKeyAlreadyDeclared  = errors.New("deploy key already declared")
+	ErrUnknownCustomProperty     = errors.New("custom property not defined")
+	ErrSingleValueIsExpected     = errors.New("a single value is expected")
+	ErrInvalidPropertyValue      = errors.New("value not allowed")
+	ErrAutolinkUrlIsMandatory    = errors.New("url template is mandatory")
+	ErrForbiddenBranchConflict   = errors.New("forbidden branch conflicts with")
+	ErrForbiddenBranchProtection = errors.New("forbid is only available for branch-protections")
+	ErrUndeclaredTeam            = errors.New("team not declared")
 
 	ErrWorkspacePathDoesntExist              = errors.New("workspace path doesn't exist")
 	ErrWorkspacePathIsExpectedToBeADirectory = errors.New("workspace path is expected to be a directory")
@@ -20,18 +44,45 @@ var (
 		BranchProtectionTemplateType,
 		ErrNoTemplateAvailable,
 	)
+	ErrNoTeamTemplateAvailable        = fmt.Errorf("%s template %w", TeamTemplateType, ErrNoTemplateAvailable)
+	ErrNoRulesetTemplateAvailable     = fmt.Errorf("%s template %w", RulesetTemplateType, ErrNoTemplateAvailable)
+	ErrNoEnvironmentTemplateAvailable = fmt.Errorf(
+		"%s template %w",
+		EnvironmentTemplateType,
+		ErrNoTemplateAvailable,
+	)
+	ErrNoWebhookTemplateAvailable = fmt.Errorf("%s template %w", WebhookTemplateType, ErrNoTemplateAvailable)
+	ErrNoLabelsTemplateAvailable  = fmt.Errorf("%s template %w", LabelsTemplateType, ErrNoTemplateAvailable)
 
 	ErrTemplateNotFound                 = errors.New("not found")
 	ErrRepositoryTemplateNotFound       = fmt.Errorf("%s template %w", RepositoryTemplateType, ErrTemplateNotFound)
 	ErrBranchTemplateNotFound           = fmt.Errorf("%s template %w", BranchTemplateType, ErrTemplateNotFound)
 	ErrBranchProtectionTemplateNotFound = fmt.Errorf("%s template %w", BranchProtectionTemplateType, ErrTemplateNotFound)
+	ErrTeamTemplateNotFound             = fmt.Errorf("%s template %w", TeamTemplateType, ErrTemplateNotFound)
+	ErrRulesetTemplateNotFound          = fmt.Errorf("%s template %w", RulesetTemplateType, ErrTemplateNotFound)
+	ErrEnvironmentTemplateNotFound      = fmt.Errorf("%s template %w", EnvironmentTemplateType, ErrTemplateNotFound)
+	ErrWebhookTemplateNotFound          = fmt.Errorf("%s template %w", WebhookTemplateType, ErrTemplateNotFound)
+	ErrLabelsTemplateNotFound           = fmt.Errorf("%s template %w", LabelsTemplateType, ErrTemplateNotFound)
 
 	ErrMaxTemplateCount = errors.New("maximum template count reached")
 	ErrMaxTemplateDepth = errors.New("maximum template depth reached")
 
 	ErrDuringWriteTerraformFiles = errors.New("error while writing terraform files")
+	ErrDuringPruneTerraformFiles = errors.New("error while pruning terraform files")
+	ErrDuringCheckTerraformFiles = errors.New("error while checking terraform files")
 	ErrDuringFileGeneration      = errors.New("error while generating files")
 	ErrDuringComputation         = errors.New("error during computation")
+	ErrDuringReverseMapping      = errors.New("error during reverse mapping")
+	ErrDuringTemplateExtraction  = errors.New("error during template extraction")
+
+	ErrUnknownTerraformStateFormat = errors.New("unknown terraform state format")
+	ErrStateRepositoryNotFound     = errors.New("repository not found in state")
+	ErrComputedConfigMismatch      = errors.New("computed config would differ")
+
+	ErrYamlNodeNotFound     = errors.New("YAML node not found")
+	ErrYamlFlowStyleMapping = errors.New("flow style mapping can't be rewritten")
+	ErrYamlAnchorOrAlias    = errors.New("YAML anchor or alias can't be rewritten")
+	ErrYamlMergeKey         = errors.New("mapping with YAML merge key can't be rewritten")
 
 	ErrSchemaValidation        = errors.New("schema validation error")
 	ErrEmptySchema             = errors.New("empty schema")
@@ -43,6 +94,16 @@ var (
 	ErrBranchError           = errors.New("branch")
 	ErrDefaultBranchError    = errors.New("default branch")
 	ErrBranchProtectionError = errors.New("branch protection")
+	ErrRulesetError          = errors.New("ruleset")
+	ErrEnvironmentError      = errors.New("environment")
+	ErrWebhookError          = errors.New("webhook")
+	ErrLabelsError           = errors.New("labels")
+	ErrLabelError            = errors.New("label")
+	ErrRepositoryFileError   = errors.New("repository file")
+	ErrSecurityError         = errors.New("security")
+	ErrDeployKeyError        = errors.New("deploy key")
+	ErrCustomPropertyError   = errors.New("custom property")
+	ErrAutolinkError         = errors.New("autolink")
 )
 
 func BranchError(branch string, err error) error {
@@ -57,6 +118,46 @@ func BranchProtectionError(index int, err error) error {
 	return fmt.Errorf("%w #%d: %w", ErrBranchProtectionError, index, err)
 }
 
+func EnvironmentError(environment string, err error) error {
+	return fmt.Errorf("%w %s: %w", ErrEnvironmentError, environment, err)
+}
+
+func RulesetError(index int, err error) error {
+	return fmt.Errorf("%w #%d: %w", ErrRulesetError, index, err)
+}
+
+func WebhookError(index int, err error) error {
+	return fmt.Errorf("%w #%d: %w", ErrWebhookError, index, err)
+}
+
+func LabelsError(err error) error {
+	return fmt.Errorf("%w: %w", ErrLabelsError, err)
+}
+
+func LabelError(label string, err error) error {
+	return fmt.Errorf("%w %s: %w", ErrLabelError, label, err)
+}
+
+func SecurityError(err error) error {
+	return fmt.Errorf("%w: %w", ErrSecurityError, err)
+}
+
+func DeployKeyError(index int, err error) error {
+	return fmt.Errorf("%w #%d: %w", ErrDeployKeyError, index, err)
+}
+
+func CustomPropertyError(name string, err error) error {
+	return fmt.Errorf("%w %s: %w", ErrCustomPropertyError, name, err)
+}
+
+func AutolinkError(keyPrefix string, err error) error {
+	return fmt.Errorf("%w %s: %w", ErrAutolinkError, keyPrefix, err)
+}
+
+func RepositoryFileError(path string, err error) error {
+	return fmt.Errorf("%w %s: %w", ErrRepositoryFileError, path, err)
+}
+
 func FileError(filepath string, err error) error {
 	return fmt.Errorf("%w %s: %w", ErrFileError, filepath, err)
 }
@@ -77,6 +178,38 @@ func ComputationError(errList []error) error {
 	return fmt.Errorf("%w:\n\t - %w", ErrDuringComputation, JoinErrors(errList, "\n\t - "))
 }
 
+func ReverseMappingError(errList []error) error {
+	return fmt.Errorf("%w:\n\t - %w", ErrDuringReverseMapping, JoinErrors(errList, "\n\t - "))
+}
+
+func StateRepositoryNotFoundError(address string, repoId *string) error {
+	if repoId == nil {
+		return fmt.Errorf("%s: %w", address, ErrStateRepositoryNotFound)
+	}
+
+	return fmt.Errorf("%s: %w: %q", address, ErrStateRepositoryNotFound, *repoId)
+}
+
+func TemplateExtractionError(errList []error) error {
+	return fmt.Errorf("%w:\n\t - %w", ErrDuringTemplateExtraction, JoinErrors(errList, "\n\t - "))
+}
+
+func TemplateExtractionMismatchError(repoName string) error {
+	return fmt.Errorf("repository %s: %w", repoName, ErrComputedConfigMismatch)
+}
+
+func YamlRewriteError(repoName string, path string, err error) error {
+	if path == "" {
+		return fmt.Errorf("repository %s: %w", repoName, err)
+	}
+
+	return fmt.Errorf("repository %s: %s: %w", repoName, path, err)
+}
+
+func YamlEntryError(key string, err error) error {
+	return fmt.Errorf("%s: %w", key, err)
+}
+
 func RepositoryNameIsMandatoryForConfigIndexError(index int) error {
 	return fmt.Errorf("config #%d: %w", index, ErrRepositoryNameIsMandatory)
 }
@@ -85,6 +218,54 @@ func RepositoryNameIsMandatoryForRepoError(index int) error {
 	return fmt.Errorf("repo #%d: %w", index, ErrRepositoryNameIsMandatory)
 }
 
+func TeamNameIsMandatoryForTeamError(index int) error {
+	return fmt.Errorf("team #%d: %w", index, ErrTeamNameIsMandatory)
+}
+
+func TeamAlreadyDeclaredError(name string) error {
+	return fmt.Errorf("team %s: %w", name, ErrTeamAlreadyDeclared)
+}
+
+func UnknownTeamError(name string) error {
+	return fmt.Errorf("team %s: %w", name, ErrUnknownTeam)
+}
+
+func OrgRulesetNameIsMandatoryError(index int) error {
+	return fmt.Errorf("organization ruleset #%d: %w", index, ErrRulesetNameIsMandatory)
+}
+
+func OrgRulesetAlreadyDeclaredError(name string) error {
+	return fmt.Errorf("organization ruleset %s: %w", name, ErrOrgRulesetAlreadyDeclared)
+}
+
+func UnknownRepositoryError(name string) error {
+	return fmt.Errorf("repository %s: %w", name, ErrUnknownRepository)
+}
+
+func UnknownRepositoryTopicError(topic string) error {
+	return fmt.Errorf("topic %s: %w", topic, ErrUnknownRepositoryTopic)
+}
+
+func FileSourceNotFoundError(path string) error {
+	return fmt.Errorf("%w: %s", ErrFileSourceNotFound, path)
+}
+
+func DeployKeyFileNotFoundError(path string) error {
+	return fmt.Errorf("%w: %s", ErrDeployKeyFileNotFound, path)
+}
+
+func UndeclaredBypasserTeamError(bypasser string) error {
+	return fmt.Errorf("bypasser %s: %w", bypasser, ErrUndeclaredTeam)
+}
+
+func ForbiddenBranchConflictError(property string) error {
+	return fmt.Errorf("%w '%s'", ErrForbiddenBranchConflict, property)
+}
+
+func InvalidPropertyValueError(value string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidPropertyValue, value)
+}
+
 func UnknownTemplateError(tplType string, tplName string) error {
 	var baseError error
 
@@ -95,6 +276,16 @@ func UnknownTemplateError(tplType string, tplName string) error {
 		baseError = ErrBranchTemplateNotFound
 	case BranchProtectionTemplateType:
 		baseError = ErrBranchProtectionTemplateNotFound
+	case TeamTemplateType:
+		baseError = ErrTeamTemplateNotFound
+	case RulesetTemplateType:
+		baseError = ErrRulesetTemplateNotFound
+	case EnvironmentTemplateType:
+		baseError = ErrEnvironmentTemplateNotFound
+	case WebhookTemplateType:
+		baseError = ErrWebhookTemplateNotFound
+	case LabelsTemplateType:
+		baseError = ErrLabelsTemplateNotFound
 	default:
 		return fmt.Errorf("\"%s\" %s template %w", tplName, tplType, ErrTemplateNotFound)
 	}
@@ -110,6 +301,16 @@ func NoTemplateAvailableError(tplType string) error {
 		return ErrNoBranchTemplateAvailable
 	case BranchProtectionTemplateType:
 		return ErrNoBranchProtectionTemplateAvailable
+	case TeamTemplateType:
+		return ErrNoTeamTemplateAvailable
+	case RulesetTemplateType:
+		return ErrNoRulesetTemplateAvailable
+	case EnvironmentTemplateType:
+		return ErrNoEnvironmentTemplateAvailable
+	case WebhookTemplateType:
+		return ErrNoWebhookTemplateAvailable
+	case LabelsTemplateType:
+		return ErrNoLabelsTemplateAvailable
 	default:
 		return fmt.Errorf("%s template %w", tplType, ErrNoTemplateAvailable)
 	}
@@ -177,6 +378,14 @@ func TerraformFileWritingErrors(errList []error) error {
 	return fmt.Errorf("%w:\n\t - %w", ErrDuringWriteTerraformFiles, JoinErrors(errList, "\n\t - "))
 }
 
+func TerraformFilesPruningError(errList []error) error {
+	return fmt.Errorf("%w:\n\t - %w", ErrDuringPruneTerraformFiles, JoinErrors(errList, "\n\t - "))
+}
+
+func TerraformFilesCheckError(errList []error) error {
+	return fmt.Errorf("%w:\n\t - %w", ErrDuringCheckTerraformFiles, JoinErrors(errList, "\n\t - "))
+}
+
 func JoinErrors(errList []error, separator string) error {
 	if separator == "\n" {
 		return errors.Join(errList...)
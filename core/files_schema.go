@@ -0,0 +1,61 @@
+package core
+
+var (
+	// FilesDirectory is the directory containing source files of repository files, current directory is used if nil.
+	//
+	//nolint:gochecknoglobals //Easier to manage it as exported variable
+	FilesDirectory *string
+	// FilesModuleDirectory is the path to FilesDirectory, relative to the generated terraform module.
+	//
+	//nolint:gochecknoglobals //Easier to manage it as exported variable
+	FilesModuleDirectory = "../files"
+)
+
+// GhRepoFilesConfig contains file configurations by path in the repository, a nil configuration means default one.
+type GhRepoFilesConfig map[string]*GhRepoFileConfig
+
+func (to *GhRepoFilesConfig) Merge(from *GhRepoFilesConfig) {
+	if from == nil {
+		return
+	}
+
+	for path, fileConfig := range *from {
+		existingVal, exists := (*to)[path]
+
+		switch {
+		case exists && existingVal != nil:
+			existingVal.Merge(fileConfig)
+		case fileConfig == nil:
+			(*to)[path] = nil
+		default:
+			//nolint:exhaustruct // No need here, it's base structure
+			newVal := &GhRepoFileConfig{}
+			newVal.Merge(fileConfig)
+			(*to)[path] = newVal
+		}
+	}
+}
+
+type GhRepoFileConfig struct {
+	// Source is the path of the file content, relative to the files directory. Repository path is used if not provided
+	Source *string `yaml:"source,omitempty"`
+	// Branch is the repository default branch if not provided
+	Branch            *string `yaml:"branch,omitempty"`
+	CommitMessage     *string `yaml:"commit-message,omitempty"`
+	OverwriteOnCreate *string `yaml:"overwrite-on-create,omitempty"`
+	// IgnoreChanges contains attributes ("content" and/or "commit-message") for which changes made outside of
+	// terraform are ignored
+	IgnoreChanges *[]string `yaml:"ignore-changes,omitempty,flow"`
+}
+
+func (to *GhRepoFileConfig) Merge(from *GhRepoFileConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeStringIfNotNil(&to.Source, from.Source)
+	mergeStringIfNotNil(&to.Branch, from.Branch)
+	mergeStringIfNotNil(&to.CommitMessage, from.CommitMessage)
+	mergeStringIfNotNil(&to.OverwriteOnCreate, from.OverwriteOnCreate)
+	mergeSliceIfNotNil(&to.IgnoreChanges, from.IgnoreChanges)
+}
@@ -0,0 +1,88 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+func updateGhRepoFileConfigHelper(c *core.GhRepoFileConfig, stringToCopy *string, newSliceToCopy *[]string, updatePtr bool) {
+	updateStringPtrHelper(&c.Source, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.Branch, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.CommitMessage, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.OverwriteOnCreate, stringToCopy, updatePtr)
+	updateSlicePtrHelper(&c.IgnoreChanges, newSliceToCopy, updatePtr)
+}
+
+func TestGhRepoFileConfig_Merge(t *testing.T) {
+	t.Parallel()
+	// Ensure updating from afterward doesn't affect result of merge and vice versa
+	ensureMergeWithoutOverflowBetweenToAndFrom(
+		t,
+		core.GhRepoFileConfig{},
+		func(to, from *core.GhRepoFileConfig) {
+			to.Merge(from)
+		},
+		updateGhRepoFileConfigHelper,
+	)
+}
+
+func TestGhRepoFilesConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	source1 := "source1"
+	source2 := "source2"
+	branch := "a-branch"
+	cases := map[string]struct {
+		value    *core.GhRepoFilesConfig
+		from     *core.GhRepoFilesConfig
+		expected *core.GhRepoFilesConfig
+	}{
+		"full": {
+			&core.GhRepoFilesConfig{
+				"FILE1": nil,
+				"FILE2": {Source: &source1},
+				"FILE3": {Source: &source1},
+			},
+			&core.GhRepoFilesConfig{
+				"FILE2": nil,
+				"FILE3": {Source: &source2, Branch: &branch},
+				"FILE4": nil,
+				"FILE5": {Branch: &branch},
+			},
+			&core.GhRepoFilesConfig{
+				"FILE1": nil,
+				"FILE2": {Source: &source1},
+				"FILE3": {Source: &source2, Branch: &branch},
+				"FILE4": nil,
+				"FILE5": {Branch: &branch},
+			},
+		},
+		"to is empty": {
+			&core.GhRepoFilesConfig{},
+			&core.GhRepoFilesConfig{"FILE1": nil, "FILE2": {Source: &source1}},
+			&core.GhRepoFilesConfig{"FILE1": nil, "FILE2": {Source: &source1}},
+		},
+		"from nil": {
+			&core.GhRepoFilesConfig{"FILE1": nil},
+			nil,
+			&core.GhRepoFilesConfig{"FILE1": nil},
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+				tc.value.Merge(tc.from)
+
+				if diff := cmp.Diff(tc.expected, tc.value); diff != "" {
+					t.Errorf("Config mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,274 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+/** Public **/
+
+// MapToActionsVariableResList returns resources sorted by variable name.
+func MapToActionsVariableResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) []*ActionsVariableRes {
+	if repoConfig == nil || repoConfig.Actions == nil || repoConfig.Actions.Variables == nil {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := []*ActionsVariableRes{}
+	keys, values := MapToSortedListWithKeys(*repoConfig.Actions.Variables)
+
+	for idx, name := range keys {
+		list = append(list, &ActionsVariableRes{
+			ValueGenerator: valGen,
+			Identifier:     mapActionsTfId(repoTfId, name),
+			Repository:     repoName,
+			VariableName:   &keys[idx],
+			Value:          &values[idx],
+		})
+	}
+
+	return list
+}
+
+// MapToActionsSecretResList returns resources sorted by secret name.
+func MapToActionsSecretResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) []*ActionsSecretRes {
+	if repoConfig == nil || repoConfig.Actions == nil || repoConfig.Actions.Secrets == nil {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := []*ActionsSecretRes{}
+	keys, secrets := MapToSortedListWithKeys(*repoConfig.Actions.Secrets)
+
+	for idx, name := range keys {
+		identifier := mapActionsTfId(repoTfId, name)
+		plaintextValue, encryptedValue := mapActionsSecretValue(secrets[idx], identifier)
+
+		list = append(list, &ActionsSecretRes{
+			ValueGenerator: valGen,
+			Identifier:     identifier,
+			Repository:     repoName,
+			SecretName:     &keys[idx],
+			PlaintextValue: plaintextValue,
+			EncryptedValue: encryptedValue,
+		})
+	}
+
+	return list
+}
+
+// MapToActionsEnvironmentVariableResList returns resources sorted by environment name, then by variable name.
+func MapToActionsEnvironmentVariableResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) []*ActionsEnvironmentVariableRes {
+	if repoConfig == nil || repoConfig.Environments == nil {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := []*ActionsEnvironmentVariableRes{}
+	envKeys, environments := MapToSortedListWithKeys(*repoConfig.Environments)
+
+	for envIdx, envName := range envKeys {
+		actions := environments[envIdx].Actions
+		if actions == nil || actions.Variables == nil {
+			continue
+		}
+
+		envTfId := mapEnvironmentTfId(repoTfId, envName)
+		environment := mapActionsEnvironmentLink(envTfId)
+		keys, values := MapToSortedListWithKeys(*actions.Variables)
+
+		for idx, name := range keys {
+			list = append(list, &ActionsEnvironmentVariableRes{
+				ValueGenerator: valGen,
+				Identifier:     mapActionsTfId(envTfId, name),
+				Repository:     repoName,
+				Environment:    environment,
+				VariableName:   &keys[idx],
+				Value:          &values[idx],
+			})
+		}
+	}
+
+	return list
+}
+
+// MapToActionsEnvironmentSecretResList returns resources sorted by environment name, then by secret name.
+func MapToActionsEnvironmentSecretResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) []*ActionsEnvironmentSecretRes {
+	if repoConfig == nil || repoConfig.Environments == nil {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := []*ActionsEnvironmentSecretRes{}
+	envKeys, environments := MapToSortedListWithKeys(*repoConfig.Environments)
+
+	for envIdx, envName := range envKeys {
+		actions := environments[envIdx].Actions
+		if actions == nil || actions.Secrets == nil {
+			continue
+		}
+
+		envTfId := mapEnvironmentTfId(repoTfId, envName)
+		environment := mapActionsEnvironmentLink(envTfId)
+		keys, secrets := MapToSortedListWithKeys(*actions.Secrets)
+
+		for idx, name := range keys {
+			identifier := mapActionsTfId(envTfId, name)
+			plaintextValue, encryptedValue := mapActionsSecretValue(secrets[idx], identifier)
+
+			list = append(list, &ActionsEnvironmentSecretRes{
+				ValueGenerator: valGen,
+				Identifier:     identifier,
+				Repository:     repoName,
+				Environment:    environment,
+				SecretName:     &keys[idx],
+				PlaintextValue: plaintextValue,
+				EncryptedValue: encryptedValue,
+			})
+		}
+	}
+
+	return list
+}
+
+// MapToActionsRepositoryPermissionsRes returns nil if there is no actions permissions configuration.
+//
+// Selected actions configuration is ignored if "selected" actions are not explicitly allowed.
+func MapToActionsRepositoryPermissionsRes(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) *ActionsRepositoryPermissionsRes {
+	if repoConfig == nil || repoConfig.Actions == nil || repoConfig.Actions.Permissions == nil {
+		return nil
+	}
+
+	permissions := repoConfig.Actions.Permissions
+	//nolint:exhaustruct // No need here, simple init
+	res := &ActionsRepositoryPermissionsRes{
+		ValueGenerator: valGen,
+		Identifier:     repoTfId,
+		Repository:     mapRepositoryNameLink(repoConfig, repoTfId, links...),
+		Enabled:        permissions.Enabled,
+		AllowedActions: permissions.AllowedActions,
+	}
+
+	hasSelectedConfig := permissions.GithubOwnedAllowed != nil || permissions.VerifiedAllowed != nil ||
+		permissions.Patterns != nil
+
+	switch {
+	case permissions.AllowedActions != nil && *permissions.AllowedActions == "selected":
+		res.GithubOwnedAllowed = permissions.GithubOwnedAllowed
+		res.VerifiedAllowed = permissions.VerifiedAllowed
+		res.PatternsAllowed = permissions.Patterns
+	case hasSelectedConfig:
+		log.Warn().Msgf(
+			"Repository %s: selected actions configuration requires selected actions to be allowed => ignored",
+			*repoConfig.Name,
+		)
+	}
+
+	return res
+}
+
+// MapToWorkflowRepositoryPermissionsRes returns nil if there is no actions permissions configuration.
+func MapToWorkflowRepositoryPermissionsRes(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) *WorkflowRepositoryPermissionsRes {
+	if repoConfig == nil || repoConfig.Actions == nil || repoConfig.Actions.Permissions == nil {
+		return nil
+	}
+
+	return &WorkflowRepositoryPermissionsRes{
+		ValueGenerator:               valGen,
+		Identifier:                   repoTfId,
+		Repository:                   mapRepositoryNameLink(repoConfig, repoTfId, links...),
+		DefaultWorkflowPermissions:   repoConfig.Actions.Permissions.DefaultWorkflowPermissions,
+		CanApprovePullRequestReviews: repoConfig.Actions.Permissions.CanApprovePullRequestReviews,
+	}
+}
+
+// MapToActionsSecretInputVariableList returns the name of terraform input variables expected to provide repository
+// and environment secret values, in the same order as secret resources.
+func MapToActionsSecretInputVariableList(repoConfig *GhRepoConfig, repoTfId string) []string {
+	valGen := tfsig.NewValueGenerator()
+	list := []string{}
+
+	for _, res := range MapToActionsSecretResList(repoConfig, valGen, repoTfId) {
+		list = append(list, actionsSecretInputVariable(res.PlaintextValue, res.EncryptedValue))
+	}
+
+	for _, res := range MapToActionsEnvironmentSecretResList(repoConfig, valGen, repoTfId) {
+		list = append(list, actionsSecretInputVariable(res.PlaintextValue, res.EncryptedValue))
+	}
+
+	return list
+}
+
+/** Private **/
+
+func mapActionsTfId(parentTfId string, name string) string {
+	return fmt.Sprintf("%s-%s", parentTfId, tfsig.ToTerraformIdentifier(name))
+}
+
+func mapActionsEnvironmentLink(envTfId string) *string {
+	// /!\ Always reference the environment, as the variable or secret can't be configured if environment doesn't exist
+	environment := fmt.Sprintf("github_repository_environment.%s.environment", envTfId)
+
+	return &environment
+}
+
+// mapActionsSecretValue returns either the plaintext value or the encrypted value, as a reference to the terraform
+// input variable providing it.
+//
+// Variable name is the resource identifier, unless a specific one is configured.
+func mapActionsSecretValue(secretConfig *GhActionsSecretConfig, identifier string) (*string, *string) {
+	variable := identifier
+	if secretConfig != nil && secretConfig.Variable != nil {
+		variable = *secretConfig.Variable
+	}
+
+	value := "var." + variable
+
+	if secretConfig != nil && secretConfig.Encrypted != nil && *secretConfig.Encrypted == "true" {
+		return nil, &value
+	}
+
+	return &value, nil
+}
+
+func actionsSecretInputVariable(plaintextValue *string, encryptedValue *string) string {
+	if plaintextValue != nil {
+		return strings.TrimPrefix(*plaintextValue, "var.")
+	}
+
+	return strings.TrimPrefix(*encryptedValue, "var.")
+}
@@ -0,0 +1,205 @@
+package core
+
+import (
+	"github.com/yoanm/go-tfsig"
+)
+
+// ActionsVariableRes contains `github_actions_variable` resource attributes.
+type ActionsVariableRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Repository     *string
+	VariableName   *string
+	Value          *string
+}
+
+// ActionsSecretRes contains `github_actions_secret` resource attributes.
+type ActionsSecretRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Repository     *string
+	SecretName     *string
+	PlaintextValue *string
+	EncryptedValue *string
+}
+
+// ActionsEnvironmentVariableRes contains `github_actions_environment_variable` resource attributes.
+type ActionsEnvironmentVariableRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Repository     *string
+	Environment    *string
+	VariableName   *string
+	Value          *string
+}
+
+// ActionsEnvironmentSecretRes contains `github_actions_environment_secret` resource attributes.
+type ActionsEnvironmentSecretRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Repository     *string
+	Environment    *string
+	SecretName     *string
+	PlaintextValue *string
+	EncryptedValue *string
+}
+
+// ActionsRepositoryPermissionsRes contains `github_actions_repository_permissions` resource attributes.
+type ActionsRepositoryPermissionsRes struct {
+	ValueGenerator     tfsig.ValueGenerator
+	Identifier         string
+	Repository         *string
+	Enabled            *string
+	AllowedActions     *string
+	GithubOwnedAllowed *string
+	VerifiedAllowed    *string
+	PatternsAllowed    *[]string
+}
+
+// WorkflowRepositoryPermissionsRes contains `github_workflow_repository_permissions` resource attributes.
+type WorkflowRepositoryPermissionsRes struct {
+	ValueGenerator               tfsig.ValueGenerator
+	Identifier                   string
+	Repository                   *string
+	DefaultWorkflowPermissions   *string
+	CanApprovePullRequestReviews *string
+}
+
+/** Public **/
+
+// NewActionsVariableSignature returns the `github_actions_variable` terraform resource as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewActionsVariableSignature(res *ActionsVariableRes) *tfsig.BlockSignature {
+	if res == nil || res.Repository == nil || res.VariableName == nil || res.Value == nil {
+		return nil
+	}
+
+	sig := tfsig.NewResource("github_actions_variable", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", res.ValueGenerator.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "variable_name", res.ValueGenerator.ToString(res.VariableName))
+	tfsig.AppendAttributeIfNotNil(sig, "value", res.ValueGenerator.ToString(res.Value))
+
+	return sig
+}
+
+// NewActionsSecretSignature returns the `github_actions_secret` terraform resource as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewActionsSecretSignature(res *ActionsSecretRes) *tfsig.BlockSignature {
+	if res == nil || res.Repository == nil || res.SecretName == nil {
+		return nil
+	}
+
+	sig := tfsig.NewResource("github_actions_secret", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", res.ValueGenerator.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "secret_name", res.ValueGenerator.ToString(res.SecretName))
+	tfsig.AppendAttributeIfNotNil(sig, "plaintext_value", res.ValueGenerator.ToIdent(res.PlaintextValue))
+	tfsig.AppendAttributeIfNotNil(sig, "encrypted_value", res.ValueGenerator.ToIdent(res.EncryptedValue))
+
+	return sig
+}
+
+// NewActionsEnvironmentVariableSignature returns the `github_actions_environment_variable` terraform resource as
+// `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewActionsEnvironmentVariableSignature(res *ActionsEnvironmentVariableRes) *tfsig.BlockSignature {
+	if res == nil || res.Repository == nil || res.Environment == nil || res.VariableName == nil || res.Value == nil {
+		return nil
+	}
+
+	sig := tfsig.NewResource("github_actions_environment_variable", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", res.ValueGenerator.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "environment", res.ValueGenerator.ToString(res.Environment))
+	tfsig.AppendAttributeIfNotNil(sig, "variable_name", res.ValueGenerator.ToString(res.VariableName))
+	tfsig.AppendAttributeIfNotNil(sig, "value", res.ValueGenerator.ToString(res.Value))
+
+	return sig
+}
+
+// NewActionsEnvironmentSecretSignature returns the `github_actions_environment_secret` terraform resource as
+// `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewActionsEnvironmentSecretSignature(res *ActionsEnvironmentSecretRes) *tfsig.BlockSignature {
+	if res == nil || res.Repository == nil || res.Environment == nil || res.SecretName == nil {
+		return nil
+	}
+
+	sig := tfsig.NewResource("github_actions_environment_secret", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", res.ValueGenerator.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "environment", res.ValueGenerator.ToString(res.Environment))
+	tfsig.AppendAttributeIfNotNil(sig, "secret_name", res.ValueGenerator.ToString(res.SecretName))
+	tfsig.AppendAttributeIfNotNil(sig, "plaintext_value", res.ValueGenerator.ToIdent(res.PlaintextValue))
+	tfsig.AppendAttributeIfNotNil(sig, "encrypted_value", res.ValueGenerator.ToIdent(res.EncryptedValue))
+
+	return sig
+}
+
+// NewActionsRepositoryPermissionsSignature returns the `github_actions_repository_permissions` terraform resource as
+// `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewActionsRepositoryPermissionsSignature(res *ActionsRepositoryPermissionsRes) *tfsig.BlockSignature {
+	if res == nil || res.Repository == nil || (res.Enabled == nil && res.AllowedActions == nil) {
+		return nil
+	}
+
+	valGen := res.ValueGenerator
+	sig := tfsig.NewResource("github_actions_repository_permissions", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", valGen.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "enabled", valGen.ToBool(res.Enabled))
+	tfsig.AppendAttributeIfNotNil(sig, "allowed_actions", valGen.ToString(res.AllowedActions))
+
+	if res.GithubOwnedAllowed != nil || res.VerifiedAllowed != nil || res.PatternsAllowed != nil {
+		githubOwnedAllowed := res.GithubOwnedAllowed
+		if githubOwnedAllowed == nil {
+			// Attribute is mandatory
+			githubOwnedAllowed = &falseString
+		}
+
+		configSig := tfsig.NewSignature("allowed_actions_config")
+		tfsig.AppendAttributeIfNotNil(configSig, "github_owned_allowed", valGen.ToBool(githubOwnedAllowed))
+		tfsig.AppendAttributeIfNotNil(configSig, "verified_allowed", valGen.ToBool(res.VerifiedAllowed))
+		tfsig.AppendAttributeIfNotNil(configSig, "patterns_allowed", valGen.ToStringList(res.PatternsAllowed))
+
+		sig.AppendEmptyLine()
+		sig.AppendChild(configSig)
+	}
+
+	return sig
+}
+
+// NewWorkflowRepositoryPermissionsSignature returns the `github_workflow_repository_permissions` terraform resource
+// as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewWorkflowRepositoryPermissionsSignature(res *WorkflowRepositoryPermissionsRes) *tfsig.BlockSignature {
+	if res == nil || res.Repository == nil ||
+		(res.DefaultWorkflowPermissions == nil && res.CanApprovePullRequestReviews == nil) {
+		return nil
+	}
+
+	valGen := res.ValueGenerator
+	sig := tfsig.NewResource("github_workflow_repository_permissions", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", valGen.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(
+		sig,
+		"default_workflow_permissions",
+		valGen.ToString(res.DefaultWorkflowPermissions),
+	)
+	tfsig.AppendAttributeIfNotNil(
+		sig,
+		"can_approve_pull_request_reviews",
+		valGen.ToBool(res.CanApprovePullRequestReviews),
+	)
+
+	return sig
+}
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+/** Public **/
+
+// MapToAutolinkReferenceResList returns resources sorted by key prefix.
+func MapToAutolinkReferenceResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) []*AutolinkReferenceRes {
+	if repoConfig == nil || repoConfig.Autolinks == nil {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := []*AutolinkReferenceRes{}
+	keys, autolinks := MapToSortedListWithKeys(*repoConfig.Autolinks)
+
+	for idx, autolinkConfig := range autolinks {
+		if autolinkConfig == nil || autolinkConfig.UrlTemplate == nil {
+			continue
+		}
+
+		keyPrefix := keys[idx]
+		list = append(list, &AutolinkReferenceRes{
+			ValueGenerator:    valGen,
+			Identifier:        fmt.Sprintf("%s-%s", repoTfId, tfsig.ToTerraformIdentifier(keyPrefix)),
+			Repository:        repoName,
+			KeyPrefix:         &keyPrefix,
+			TargetUrlTemplate: autolinkConfig.UrlTemplate,
+			IsAlphanumeric:    autolinkConfig.Alphanumeric,
+		})
+	}
+
+	return list
+}
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"github.com/yoanm/go-tfsig"
+)
+
+// AutolinkReferenceRes contains `github_repository_autolink_reference` resource attributes.
+type AutolinkReferenceRes struct {
+	ValueGenerator    tfsig.ValueGenerator
+	Identifier        string
+	Repository        *string
+	KeyPrefix         *string
+	TargetUrlTemplate *string
+	IsAlphanumeric    *string
+}
+
+/** Public **/
+
+// NewAutolinkReferenceSignature returns the `github_repository_autolink_reference` terraform resource as
+// `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewAutolinkReferenceSignature(res *AutolinkReferenceRes) *tfsig.BlockSignature {
+	if res == nil || res.Repository == nil || res.KeyPrefix == nil || res.TargetUrlTemplate == nil {
+		return nil
+	}
+
+	valGen := res.ValueGenerator
+	sig := tfsig.NewResource("github_repository_autolink_reference", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", valGen.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "key_prefix", valGen.ToString(res.KeyPrefix))
+	tfsig.AppendAttributeIfNotNil(sig, "target_url_template", valGen.ToString(res.TargetUrlTemplate))
+	tfsig.AppendAttributeIfNotNil(sig, "is_alphanumeric", valGen.ToBool(res.IsAlphanumeric))
+
+	return sig
+}
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"github.com/yoanm/go-gh2tf/ghbranchprotect"
+	"github.com/yoanm/go-tfsig"
+)
+
+// BranchProtectionExtraRes contains `github_branch_protection` resource attributes not managed by go-gh2tf.
+type BranchProtectionExtraRes struct {
+	ValueGenerator                tfsig.ValueGenerator
+	LockBranch                    *string
+	BlocksCreations               *string
+	RequireConversationResolution *string
+	PullRequestBypassers          *[]string
+}
+
+/** Public **/
+
+// NewBranchProtectionSignature returns the `github_branch_protection` terraform resource as `tfsig.BlockSignature`,
+// including attributes not managed by go-gh2tf
+//
+// It returns `nil` if resource is empty.
+func NewBranchProtectionSignature(
+	res *ghbranchprotect.Config,
+	extraRes *BranchProtectionExtraRes,
+) *tfsig.BlockSignature {
+	sig := ghbranchprotect.NewSignature(res)
+	if sig == nil || extraRes == nil {
+		return sig
+	}
+
+	valGen := extraRes.ValueGenerator
+
+	if value := valGen.ToBool(extraRes.RequireConversationResolution); value != nil {
+		insertAttributeBeforeChildren(sig, "require_conversation_resolution", *value)
+	}
+
+	if value := valGen.ToBool(extraRes.LockBranch); value != nil {
+		insertAttributeBeforeChildren(sig, "lock_branch", *value)
+	}
+
+	if value := valGen.ToBool(extraRes.BlocksCreations); value != nil {
+		insertAttributeBeforeChildren(sig, "blocks_creations", *value)
+	}
+
+	if value := valGen.ToStringList(extraRes.PullRequestBypassers); value != nil {
+		reviewsSig := findChild(sig, "required_pull_request_reviews")
+		if reviewsSig == nil {
+			reviewsSig = tfsig.NewSignature("required_pull_request_reviews")
+			tfsig.AppendChildIfNotNil(sig, reviewsSig)
+		}
+
+		reviewsSig.AppendAttribute("pull_request_bypassers", *value)
+	}
+
+	return sig
+}
@@ -0,0 +1,65 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+/** Public **/
+
+// MapToCustomPropertyResList returns resources sorted by property name.
+//
+// Property type is provided by the workspace context. If the property is not defined, the type is guessed from the
+// value, as with a nil context.
+func MapToCustomPropertyResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	ctx *WorkspaceContext,
+	links ...MapperLink,
+) []*CustomPropertyRes {
+	if repoConfig == nil || repoConfig.CustomProperties == nil {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := []*CustomPropertyRes{}
+	keys, values := MapToSortedListWithKeys(*repoConfig.CustomProperties)
+
+	for idx, value := range values {
+		if value == nil {
+			continue
+		}
+
+		name := keys[idx]
+		propertyType := ctx.CustomPropertyType(name, *value)
+		propertyValue := []string(*value)
+
+		list = append(list, &CustomPropertyRes{
+			ValueGenerator: valGen,
+			Identifier:     fmt.Sprintf("%s-%s", repoTfId, tfsig.ToTerraformIdentifier(name)),
+			Repository:     repoName,
+			PropertyName:   &name,
+			PropertyType:   &propertyType,
+			PropertyValue:  &propertyValue,
+		})
+	}
+
+	return list
+}
+
+// CustomPropertyType returns the type of the defined property, else the type guessed from the value.
+func (ctx *WorkspaceContext) CustomPropertyType(name string, value GhCustomPropertyValue) string {
+	if ctx != nil {
+		if propertyType, ok := ctx.CustomPropertyTypes[name]; ok {
+			return propertyType
+		}
+	}
+
+	if len(value) > 1 {
+		return CustomPropertyMultiSelectType
+	}
+
+	return CustomPropertyStringType
+}
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"github.com/yoanm/go-tfsig"
+)
+
+// CustomPropertyRes contains `github_repository_custom_property` resource attributes.
+type CustomPropertyRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Repository     *string
+	PropertyName   *string
+	PropertyType   *string
+	PropertyValue  *[]string
+}
+
+/** Public **/
+
+// NewCustomPropertySignature returns the `github_repository_custom_property` terraform resource as
+// `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewCustomPropertySignature(res *CustomPropertyRes) *tfsig.BlockSignature {
+	if res == nil || res.Repository == nil || res.PropertyName == nil || res.PropertyValue == nil {
+		return nil
+	}
+
+	valGen := res.ValueGenerator
+	sig := tfsig.NewResource("github_repository_custom_property", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", valGen.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "property_name", valGen.ToString(res.PropertyName))
+	tfsig.AppendAttributeIfNotNil(sig, "property_type", valGen.ToString(res.PropertyType))
+	tfsig.AppendAttributeIfNotNil(sig, "property_value", valGen.ToStringList(res.PropertyValue))
+
+	return sig
+}
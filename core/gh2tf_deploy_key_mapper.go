@@ -0,0 +1,43 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+/** Public **/
+
+// MapToDeployKeyResList returns resources in the configuration order.
+//
+// Keys must have been loaded from their key file beforehand, see LoadGhRepoConfigFromFile.
+func MapToDeployKeyResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) []*DeployKeyRes {
+	if repoConfig == nil || repoConfig.DeployKeys == nil {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := []*DeployKeyRes{}
+
+	for _, deployKeyConfig := range *repoConfig.DeployKeys {
+		if deployKeyConfig == nil || deployKeyConfig.Title == nil || deployKeyConfig.Key == nil {
+			continue
+		}
+
+		list = append(list, &DeployKeyRes{
+			ValueGenerator: valGen,
+			Identifier:     fmt.Sprintf("%s-%s", repoTfId, tfsig.ToTerraformIdentifier(*deployKeyConfig.Title)),
+			Repository:     repoName,
+			Title:          deployKeyConfig.Title,
+			Key:            deployKeyConfig.Key,
+			ReadOnly:       deployKeyConfig.ReadOnly,
+		})
+	}
+
+	return list
+}
@@ -0,0 +1,36 @@
+package core
+
+import (
+	"github.com/yoanm/go-tfsig"
+)
+
+// DeployKeyRes contains `github_repository_deploy_key` resource attributes.
+type DeployKeyRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Repository     *string
+	Title          *string
+	Key            *string
+	ReadOnly       *string
+}
+
+/** Public **/
+
+// NewDeployKeySignature returns the `github_repository_deploy_key` terraform resource as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewDeployKeySignature(res *DeployKeyRes) *tfsig.BlockSignature {
+	if res == nil || res.Repository == nil || res.Title == nil || res.Key == nil {
+		return nil
+	}
+
+	valGen := res.ValueGenerator
+	sig := tfsig.NewResource("github_repository_deploy_key", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", valGen.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "title", valGen.ToString(res.Title))
+	tfsig.AppendAttributeIfNotNil(sig, "key", valGen.ToString(res.Key))
+	tfsig.AppendAttributeIfNotNil(sig, "read_only", valGen.ToBool(res.ReadOnly))
+
+	return sig
+}
@@ -0,0 +1,145 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+/** Public **/
+
+// MapToRepositoryEnvironmentResList returns resources sorted by environment name.
+func MapToRepositoryEnvironmentResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	ctx *WorkspaceContext,
+	links ...MapperLink,
+) []*RepositoryEnvironmentRes {
+	if repoConfig == nil || repoConfig.Environments == nil {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := []*RepositoryEnvironmentRes{}
+	keys, environments := MapToSortedListWithKeys(*repoConfig.Environments)
+
+	trueValue, falseValue := "true", "false"
+
+	for idx, envName := range keys {
+		envConfig := environments[idx]
+
+		//nolint:exhaustruct // No need here, simple init
+		res := &RepositoryEnvironmentRes{
+			ValueGenerator:    valGen,
+			Identifier:        mapEnvironmentTfId(repoTfId, envName),
+			Environment:       &keys[idx],
+			Repository:        repoName,
+			WaitTimer:         envConfig.WaitTimer,
+			PreventSelfReview: envConfig.PreventSelfReview,
+		}
+
+		if envConfig.Reviewers != nil {
+			res.ReviewerUsers = envConfig.Reviewers.Users
+			res.ReviewerTeams = mapEnvironmentReviewerTeams(repoConfig, envName, envConfig.Reviewers.Teams, ctx)
+		}
+
+		if branches := envConfig.DeploymentBranches; branches != nil {
+			// Both attributes are mandatory and exclusive, block is omitted to allow every branch
+			if isProtectedOnlyDeployment(branches) {
+				res.ProtectedBranches, res.CustomBranchPolicies = &trueValue, &falseValue
+			} else if branches.Patterns != nil {
+				res.ProtectedBranches, res.CustomBranchPolicies = &falseValue, &trueValue
+			}
+		}
+
+		list = append(list, res)
+	}
+
+	return list
+}
+
+// MapToRepositoryEnvironmentDeploymentPolicyResList returns resources sorted by environment name, then in the
+// configuration order.
+//
+// Patterns are ignored if deployments are restricted to protected branches.
+func MapToRepositoryEnvironmentDeploymentPolicyResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) []*RepositoryEnvironmentDeploymentPolicyRes {
+	if repoConfig == nil || repoConfig.Environments == nil {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := []*RepositoryEnvironmentDeploymentPolicyRes{}
+	keys, environments := MapToSortedListWithKeys(*repoConfig.Environments)
+
+	for idx, envName := range keys {
+		branches := environments[idx].DeploymentBranches
+		if branches == nil || branches.Patterns == nil || isProtectedOnlyDeployment(branches) {
+			continue
+		}
+
+		envTfId := mapEnvironmentTfId(repoTfId, envName)
+		// /!\ Always reference the environment, as the policy can't be configured if environment doesn't exist
+		environment := fmt.Sprintf("github_repository_environment.%s.environment", envTfId)
+
+		for _, pattern := range *branches.Patterns {
+			list = append(list, &RepositoryEnvironmentDeploymentPolicyRes{
+				ValueGenerator: valGen,
+				Identifier:     fmt.Sprintf("%s-%s", envTfId, tfsig.ToTerraformIdentifier(pattern)),
+				Repository:     repoName,
+				Environment:    &environment,
+				BranchPattern:  &pattern,
+			})
+		}
+	}
+
+	return list
+}
+
+/** Private **/
+
+func mapEnvironmentTfId(repoTfId string, envName string) string {
+	return fmt.Sprintf("%s-%s", repoTfId, tfsig.ToTerraformIdentifier(envName))
+}
+
+func isProtectedOnlyDeployment(branches *GhEnvironmentDeploymentBranchesConfig) bool {
+	return branches.ProtectedOnly != nil && *branches.ProtectedOnly == "true"
+}
+
+// mapEnvironmentReviewerTeams returns team IDs, teams which are neither a team ID nor a declared team are ignored.
+func mapEnvironmentReviewerTeams(
+	repoConfig *GhRepoConfig,
+	envName string,
+	teams *[]string,
+	ctx *WorkspaceContext,
+) *[]string {
+	if teams == nil {
+		return nil
+	}
+
+	list := []string{}
+
+	for _, team := range *teams {
+		if !ctx.IsTeamIdOrDeclaredTeam(team) {
+			log.Warn().Msgf(
+				"Repository %s: environment %s: unable to find %s team ID => ignored",
+				*repoConfig.Name,
+				envName,
+				team,
+			)
+
+			continue
+		}
+
+		list = append(list, ctx.TeamId(team))
+	}
+
+	return &list
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"github.com/yoanm/go-tfsig"
+)
+
+// RepositoryEnvironmentRes contains `github_repository_environment` resource attributes.
+type RepositoryEnvironmentRes struct {
+	ValueGenerator       tfsig.ValueGenerator
+	Identifier           string
+	Environment          *string
+	Repository           *string
+	WaitTimer            *string
+	PreventSelfReview    *string
+	ReviewerUsers        *[]string
+	ReviewerTeams        *[]string
+	ProtectedBranches    *string
+	CustomBranchPolicies *string
+}
+
+// RepositoryEnvironmentDeploymentPolicyRes contains `github_repository_environment_deployment_policy` resource
+// attributes.
+type RepositoryEnvironmentDeploymentPolicyRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Repository     *string
+	Environment    *string
+	BranchPattern  *string
+}
+
+/** Public **/
+
+// NewRepositoryEnvironmentSignature returns the `github_repository_environment` terraform resource as
+// `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewRepositoryEnvironmentSignature(res *RepositoryEnvironmentRes) *tfsig.BlockSignature {
+	if res == nil || res.Environment == nil || res.Repository == nil {
+		return nil
+	}
+
+	valGen := res.ValueGenerator
+	sig := tfsig.NewResource("github_repository_environment", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "environment", valGen.ToString(res.Environment))
+	tfsig.AppendAttributeIfNotNil(sig, "repository", valGen.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "wait_timer", valGen.ToNumber(res.WaitTimer))
+	tfsig.AppendAttributeIfNotNil(sig, "prevent_self_review", valGen.ToBool(res.PreventSelfReview))
+
+	if (res.ReviewerUsers != nil && len(*res.ReviewerUsers) > 0) ||
+		(res.ReviewerTeams != nil && len(*res.ReviewerTeams) > 0) {
+		reviewersSig := tfsig.NewSignature("reviewers")
+		tfsig.AppendAttributeIfNotNil(reviewersSig, "users", toNumberList(valGen, res.ReviewerUsers))
+		tfsig.AppendAttributeIfNotNil(reviewersSig, "teams", toNumberList(valGen, res.ReviewerTeams))
+
+		sig.AppendEmptyLine()
+		sig.AppendChild(reviewersSig)
+	}
+
+	if res.ProtectedBranches != nil || res.CustomBranchPolicies != nil {
+		policySig := tfsig.NewSignature("deployment_branch_policy")
+		tfsig.AppendAttributeIfNotNil(policySig, "protected_branches", valGen.ToBool(res.ProtectedBranches))
+		tfsig.AppendAttributeIfNotNil(policySig, "custom_branch_policies", valGen.ToBool(res.CustomBranchPolicies))
+
+		sig.AppendEmptyLine()
+		sig.AppendChild(policySig)
+	}
+
+	return sig
+}
+
+// NewRepositoryEnvironmentDeploymentPolicySignature returns the `github_repository_environment_deployment_policy`
+// terraform resource as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewRepositoryEnvironmentDeploymentPolicySignature(
+	res *RepositoryEnvironmentDeploymentPolicyRes,
+) *tfsig.BlockSignature {
+	if res == nil || res.Environment == nil || res.Repository == nil || res.BranchPattern == nil {
+		return nil
+	}
+
+	valGen := res.ValueGenerator
+	sig := tfsig.NewResource("github_repository_environment_deployment_policy", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", valGen.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "environment", valGen.ToString(res.Environment))
+	tfsig.AppendAttributeIfNotNil(sig, "branch_pattern", valGen.ToString(res.BranchPattern))
+
+	return sig
+}
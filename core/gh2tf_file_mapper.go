@@ -0,0 +1,111 @@
+package core
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+/** Public **/
+
+// MapToRepositoryFileResList returns resources sorted by file path.
+func MapToRepositoryFileResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) []*RepositoryFileRes {
+	if repoConfig == nil || repoConfig.Files == nil {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := []*RepositoryFileRes{}
+	keys, files := MapToSortedListWithKeys(*repoConfig.Files)
+
+	for idx, fileConfig := range files {
+		filePath := keys[idx]
+		content := fmt.Sprintf(
+			"file(\"${path.module}/%s\")",
+			escapeHclStringLiteral(path.Join(FilesModuleDirectory, MapRepositoryFileSource(filePath, fileConfig))),
+		)
+		res := &RepositoryFileRes{
+			ValueGenerator: valGen,
+			Identifier:     fmt.Sprintf("%s-%s", repoTfId, tfsig.ToTerraformIdentifier(filePath)),
+			Repository:     repoName,
+			File:           &filePath,
+			Content:        &content,
+		}
+
+		if fileConfig != nil {
+			res.Branch = mapRepositoryFileBranchLink(fileConfig.Branch, repoConfig, repoTfId, links...)
+			res.CommitMessage = fileConfig.CommitMessage
+			res.OverwriteOnCreate = fileConfig.OverwriteOnCreate
+			res.IgnoreChanges = mapRepositoryFileIgnoreChanges(fileConfig.IgnoreChanges)
+		}
+
+		list = append(list, res)
+	}
+
+	return list
+}
+
+// MapRepositoryFileSource returns the source file path, relative to the files directory.
+func MapRepositoryFileSource(filePath string, fileConfig *GhRepoFileConfig) string {
+	if fileConfig != nil && fileConfig.Source != nil {
+		return *fileConfig.Source
+	}
+
+	return filePath
+}
+
+/** Private **/
+
+func mapRepositoryFileBranchLink(
+	branch *string,
+	repoConfig *GhRepoConfig,
+	repoTfId string,
+	links ...MapperLink,
+) *string {
+	if branch == nil || !slices.Contains(links, LinkToBranch) {
+		return branch
+	}
+
+	// /!\ a file can't be committed if the branch doesn't exist
+	return linkBranch(branch, repoConfig, repoTfId)
+}
+
+func mapRepositoryFileIgnoreChanges(ignoreChanges *[]string) []string {
+	if ignoreChanges == nil {
+		return nil
+	}
+
+	list := []string{}
+
+	for _, attribute := range *ignoreChanges {
+		// Configuration uses hyphens where terraform uses underscores
+		attribute = strings.ReplaceAll(attribute, "-", "_")
+		// Templates may append an already existing attribute
+		if !slices.Contains(list, attribute) {
+			list = append(list, attribute)
+		}
+	}
+
+	return list
+}
+
+// escapeHclStringLiteral escapes the value in order to be used inside a quoted HCL string, template sequences included.
+func escapeHclStringLiteral(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+		"${", "$${",
+		"%{", "%%{",
+	).Replace(value)
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"github.com/yoanm/go-tfsig"
+)
+
+// RepositoryFileRes contains `github_repository_file` resource attributes.
+type RepositoryFileRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Repository     *string
+	File           *string
+	// Content is a terraform expression loading the source file
+	Content           *string
+	Branch            *string
+	CommitMessage     *string
+	OverwriteOnCreate *string
+	IgnoreChanges     []string
+}
+
+/** Public **/
+
+// NewRepositoryFileSignature returns the `github_repository_file` terraform resource as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewRepositoryFileSignature(res *RepositoryFileRes) *tfsig.BlockSignature {
+	if res == nil || res.Repository == nil || res.File == nil || res.Content == nil {
+		return nil
+	}
+
+	valGen := res.ValueGenerator
+	sig := tfsig.NewResource("github_repository_file", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", valGen.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "file", valGen.ToString(res.File))
+	tfsig.AppendAttributeIfNotNil(sig, "content", valGen.ToIdent(res.Content))
+	tfsig.AppendAttributeIfNotNil(sig, "branch", valGen.ToString(res.Branch))
+	tfsig.AppendAttributeIfNotNil(sig, "commit_message", valGen.ToString(res.CommitMessage))
+	tfsig.AppendAttributeIfNotNil(sig, "overwrite_on_create", valGen.ToBool(res.OverwriteOnCreate))
+
+	if len(res.IgnoreChanges) > 0 {
+		sig.Lifecycle(
+			tfsig.LifecycleConfig{
+				CreateBeforeDestroy: nil,
+				PreventDestroy:      nil,
+				IgnoreChanges:       res.IgnoreChanges,
+				ReplaceTriggeredBy:  nil,
+				Precondition:        nil,
+				Postcondition:       nil,
+			},
+		)
+	}
+
+	return sig
+}
@@ -0,0 +1,80 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+/** Public **/
+
+// MapToIssueLabelResList returns resources in the configuration order, or nil if labels are authoritative.
+func MapToIssueLabelResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) []*IssueLabelRes {
+	if repoConfig == nil || repoConfig.Labels == nil || isAuthoritativeLabels(repoConfig.Labels) {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := mapIssueLabelResList(repoConfig.Labels, valGen, repoTfId)
+
+	for _, res := range list {
+		res.Repository = repoName
+	}
+
+	return list
+}
+
+// MapToIssueLabelsRes returns nil if labels are not authoritative.
+func MapToIssueLabelsRes(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) *IssueLabelsRes {
+	if repoConfig == nil || repoConfig.Labels == nil || !isAuthoritativeLabels(repoConfig.Labels) {
+		return nil
+	}
+
+	return &IssueLabelsRes{
+		ValueGenerator: valGen,
+		Identifier:     repoTfId,
+		Repository:     mapRepositoryNameLink(repoConfig, repoTfId, links...),
+		Labels:         mapIssueLabelResList(repoConfig.Labels, valGen, repoTfId),
+	}
+}
+
+/** Private **/
+
+func isAuthoritativeLabels(labelsConfig *GhLabelsConfig) bool {
+	return labelsConfig.Authoritative != nil && *labelsConfig.Authoritative == "true"
+}
+
+func mapIssueLabelResList(labelsConfig *GhLabelsConfig, valGen tfsig.ValueGenerator, repoTfId string) []*IssueLabelRes {
+	list := []*IssueLabelRes{}
+
+	if labelsConfig.Items == nil {
+		return list
+	}
+
+	for _, labelConfig := range *labelsConfig.Items {
+		if labelConfig == nil || labelConfig.Name == nil {
+			continue
+		}
+
+		list = append(list, &IssueLabelRes{
+			ValueGenerator: valGen,
+			Identifier:     fmt.Sprintf("%s-%s", repoTfId, tfsig.ToTerraformIdentifier(*labelConfig.Name)),
+			Repository:     nil,
+			Name:           labelConfig.Name,
+			Color:          labelConfig.Color,
+			Description:    labelConfig.Description,
+		})
+	}
+
+	return list
+}
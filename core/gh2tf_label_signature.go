@@ -0,0 +1,77 @@
+package core
+
+import (
+	"github.com/yoanm/go-tfsig"
+)
+
+// IssueLabelRes contains `github_issue_label` resource attributes.
+type IssueLabelRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Repository     *string
+	Name           *string
+	Color          *string
+	Description    *string
+}
+
+// IssueLabelsRes contains `github_issue_labels` resource attributes.
+type IssueLabelsRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Repository     *string
+	// Labels are used for `label` blocks, only name, color and description are taken into account
+	Labels []*IssueLabelRes
+}
+
+/** Public **/
+
+// NewIssueLabelSignature returns the `github_issue_label` terraform resource as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewIssueLabelSignature(res *IssueLabelRes) *tfsig.BlockSignature {
+	if res == nil || res.Repository == nil || res.Name == nil {
+		return nil
+	}
+
+	sig := tfsig.NewResource("github_issue_label", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", res.ValueGenerator.ToString(res.Repository))
+	appendIssueLabelAttributes(sig, res)
+
+	return sig
+}
+
+// NewIssueLabelsSignature returns the `github_issue_labels` terraform resource as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewIssueLabelsSignature(res *IssueLabelsRes) *tfsig.BlockSignature {
+	if res == nil || res.Repository == nil {
+		return nil
+	}
+
+	sig := tfsig.NewResource("github_issue_labels", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", res.ValueGenerator.ToString(res.Repository))
+
+	for _, labelRes := range res.Labels {
+		if labelRes == nil || labelRes.Name == nil {
+			continue
+		}
+
+		labelSig := tfsig.NewSignature("label")
+		appendIssueLabelAttributes(labelSig, labelRes)
+
+		sig.AppendEmptyLine()
+		sig.AppendChild(labelSig)
+	}
+
+	return sig
+}
+
+/** Private **/
+
+func appendIssueLabelAttributes(sig *tfsig.BlockSignature, res *IssueLabelRes) {
+	tfsig.AppendAttributeIfNotNil(sig, "name", res.ValueGenerator.ToString(res.Name))
+	tfsig.AppendAttributeIfNotNil(sig, "color", res.ValueGenerator.ToString(res.Color))
+	tfsig.AppendAttributeIfNotNil(sig, "description", res.ValueGenerator.ToString(res.Description))
+}
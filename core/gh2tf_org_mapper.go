@@ -0,0 +1,75 @@
+package core
+
+import (
+	"github.com/yoanm/go-tfsig"
+)
+
+// OrganizationSettingsTfId is the terraform identifier of the `github_organization_settings` resource.
+const OrganizationSettingsTfId = "org"
+
+/** Public **/
+
+func MapToOrganizationSettingsRes(orgConfig *GhOrgConfig, valGen tfsig.ValueGenerator) *OrganizationSettingsRes {
+	if orgConfig == nil {
+		return nil
+	}
+
+	//nolint:exhaustruct // No need here, simple init
+	res := &OrganizationSettingsRes{
+		ValueGenerator:              valGen,
+		Identifier:                  OrganizationSettingsTfId,
+		BillingEmail:                orgConfig.BillingEmail,
+		DefaultRepositoryPermission: orgConfig.DefaultRepositoryPermission,
+		WebCommitSignoffRequired:    orgConfig.WebCommitSignoff,
+	}
+
+	if orgConfig.Profile != nil {
+		res.Name = orgConfig.Profile.Name
+		res.Description = orgConfig.Profile.Description
+		res.Company = orgConfig.Profile.Company
+		res.Blog = orgConfig.Profile.Blog
+		res.Email = orgConfig.Profile.Email
+		res.TwitterUsername = orgConfig.Profile.TwitterUsername
+		res.Location = orgConfig.Profile.Location
+	}
+
+	if orgConfig.Projects != nil {
+		res.HasOrganizationProjects = orgConfig.Projects.Organization
+		res.HasRepositoryProjects = orgConfig.Projects.Repository
+	}
+
+	mapMembersCanConfig(res, orgConfig.MembersCan)
+	mapNewRepositoriesConfig(res, orgConfig.NewRepositories)
+
+	return res
+}
+
+/** Private **/
+
+func mapMembersCanConfig(res *OrganizationSettingsRes, config *GhOrgMembersCanConfig) {
+	if config == nil {
+		return
+	}
+
+	res.MembersCanCreateRepositories = config.CreateRepositories
+	res.MembersCanCreatePublicRepositories = config.CreatePublicRepositories
+	res.MembersCanCreatePrivateRepositories = config.CreatePrivateRepositories
+	res.MembersCanCreateInternalRepositories = config.CreateInternalRepositories
+	res.MembersCanCreatePages = config.CreatePages
+	res.MembersCanCreatePublicPages = config.CreatePublicPages
+	res.MembersCanCreatePrivatePages = config.CreatePrivatePages
+	res.MembersCanForkPrivateRepositories = config.ForkPrivateRepositories
+}
+
+func mapNewRepositoriesConfig(res *OrganizationSettingsRes, config *GhOrgNewRepositoriesConfig) {
+	if config == nil {
+		return
+	}
+
+	res.AdvancedSecurityEnabledForNewRepositories = config.AdvancedSecurity
+	res.DependabotAlertsEnabledForNewRepositories = config.DependabotAlerts
+	res.DependabotSecurityUpdatesEnabledForNewRepositories = config.DependabotSecurityUpdates
+	res.DependencyGraphEnabledForNewRepositories = config.DependencyGraph
+	res.SecretScanningEnabledForNewRepositories = config.SecretScanning
+	res.SecretScanningPushProtectionEnabledForNewRepositories = config.SecretScanningPushProtection
+}
@@ -0,0 +1,154 @@
+package core
+
+import (
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+/** Public **/
+
+// MapToOrganizationRulesetRes maps an organization ruleset config.
+//
+// Repositories referenced by name or topic (and patterns used alongside them) are resolved against the provided
+// repositories and targeted by ID. Patterns used alone are targeted by name, in order to cover repositories which are
+// not managed by the workspace.
+func MapToOrganizationRulesetRes(
+	rulesetConfig *GhOrgRulesetConfig,
+	valGen tfsig.ValueGenerator,
+	repos []*GhRepoConfig,
+	ctx *WorkspaceContext,
+) *OrganizationRulesetRes {
+	if rulesetConfig == nil {
+		return nil
+	}
+
+	rulesetRes := MapToRulesetRes(&rulesetConfig.GhRulesetConfig, valGen, OrganizationSettingsTfId, ctx)
+	if rulesetRes == nil {
+		return nil
+	}
+
+	//nolint:exhaustruct // No need here, simple init
+	res := &OrganizationRulesetRes{RulesetRes: rulesetRes}
+	selector := rulesetConfig.Repositories
+
+	switch {
+	case selector == nil:
+		res.RepositoryNameIncludes = &[]string{"~ALL"}
+	case selector.Names != nil || selector.Topics != nil:
+		names, err := ResolveOrgRulesetRepositories(selector, repos)
+		if err != nil {
+			log.Warn().Msgf("Organization ruleset %s: %s => ignored", *rulesetConfig.Name, err)
+		}
+
+		ids := make([]string, len(names))
+		for k, name := range names {
+			ids[k] = fmt.Sprintf("github_repository.%s.repo_id", tfsig.ToTerraformIdentifier(name))
+		}
+
+		res.RepositoryIds = &ids
+	default:
+		res.RepositoryNameIncludes = selector.Patterns
+		if res.RepositoryNameIncludes == nil {
+			res.RepositoryNameIncludes = &[]string{"~ALL"}
+		}
+
+		res.RepositoryNameExcludes = selector.Exclude
+	}
+
+	return res
+}
+
+// ResolveOrgRulesetRepositories returns the name of provided repositories matching the selector, in the provided
+// order.
+//
+// An error is returned if a name doesn't match a provided repository, or if a topic doesn't match any of them.
+func ResolveOrgRulesetRepositories(
+	selector *GhOrgRulesetRepositoriesConfig,
+	repos []*GhRepoConfig,
+) ([]string, error) {
+	if selector == nil {
+		return nil, nil
+	}
+
+	byName := map[string]bool{}
+	byTopic := map[string]bool{}
+	names := []string{}
+
+	for _, repo := range repos {
+		if repo.Name == nil || !isOrgRulesetRepositorySelected(selector, repo, byName, byTopic) {
+			continue
+		}
+
+		names = append(names, *repo.Name)
+	}
+
+	if selector.Names != nil {
+		for _, name := range *selector.Names {
+			if !byName[name] {
+				return names, UnknownRepositoryError(name)
+			}
+		}
+	}
+
+	if selector.Topics != nil {
+		for _, topic := range *selector.Topics {
+			if !byTopic[topic] {
+				return names, UnknownRepositoryTopicError(topic)
+			}
+		}
+	}
+
+	return names, nil
+}
+
+/** Private **/
+
+// isOrgRulesetRepositorySelected flags the repository name and topics found along the way, in order to detect
+// unknown references later.
+func isOrgRulesetRepositorySelected(
+	selector *GhOrgRulesetRepositoriesConfig,
+	repo *GhRepoConfig,
+	byName map[string]bool,
+	byTopic map[string]bool,
+) bool {
+	selected := false
+
+	if selector.Names != nil && slices.Contains(*selector.Names, *repo.Name) {
+		byName[*repo.Name] = true
+		selected = true
+	}
+
+	if selector.Topics != nil && repo.Miscellaneous != nil && repo.Miscellaneous.Topics != nil {
+		for _, topic := range *repo.Miscellaneous.Topics {
+			if slices.Contains(*selector.Topics, topic) {
+				byTopic[topic] = true
+				selected = true
+			}
+		}
+	}
+
+	if selector.Patterns != nil && matchAnyPattern(*selector.Patterns, *repo.Name) {
+		selected = true
+	}
+
+	if selector.Exclude != nil && matchAnyPattern(*selector.Exclude, *repo.Name) {
+		selected = false
+	}
+
+	return selected
+}
+
+func matchAnyPattern(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+
+	return false
+}
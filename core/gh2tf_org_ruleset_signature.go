@@ -0,0 +1,59 @@
+package core
+
+import (
+	"github.com/yoanm/go-tfsig"
+)
+
+// OrganizationRulesetRes contains `github_organization_ruleset` resource attributes.
+type OrganizationRulesetRes struct {
+	*RulesetRes
+	RepositoryIds          *[]string
+	RepositoryNameIncludes *[]string
+	RepositoryNameExcludes *[]string
+}
+
+/** Public **/
+
+// NewOrganizationRulesetSignature returns the `github_organization_ruleset` terraform resource as
+// `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewOrganizationRulesetSignature(res *OrganizationRulesetRes) *tfsig.BlockSignature {
+	if res == nil || res.RulesetRes == nil || res.Name == nil {
+		return nil
+	}
+
+	valGen := res.ValueGenerator
+	sig := tfsig.NewResource("github_organization_ruleset", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "name", valGen.ToString(res.Name))
+	tfsig.AppendAttributeIfNotNil(sig, "target", valGen.ToString(res.Target))
+	tfsig.AppendAttributeIfNotNil(sig, "enforcement", valGen.ToString(res.Enforcement))
+
+	// `ref_name` block is mandatory for organization rulesets
+	conditionsSig := tfsig.NewSignature("conditions")
+	conditionsSig.AppendChild(newRulesetRefNameSignature(res.RulesetRes))
+
+	if res.RepositoryIds != nil {
+		tfsig.AppendAttributeIfNotNil(conditionsSig, "repository_id", valGen.ToIdentList(res.RepositoryIds))
+	} else {
+		repoNameSig := tfsig.NewSignature("repository_name")
+		// Both attributes are mandatory
+		excludes := res.RepositoryNameExcludes
+		if excludes == nil {
+			excludes = &[]string{}
+		}
+
+		tfsig.AppendAttributeIfNotNil(repoNameSig, "include", valGen.ToStringList(res.RepositoryNameIncludes))
+		tfsig.AppendAttributeIfNotNil(repoNameSig, "exclude", valGen.ToStringList(excludes))
+
+		conditionsSig.AppendChild(repoNameSig)
+	}
+
+	sig.AppendEmptyLine()
+	sig.AppendChild(conditionsSig)
+
+	appendRulesetCommonBlocks(sig, res.RulesetRes)
+
+	return sig
+}
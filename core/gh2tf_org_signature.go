@@ -0,0 +1,112 @@
+package core
+
+import (
+	"github.com/yoanm/go-tfsig"
+)
+
+// OrganizationSettingsRes contains `github_organization_settings` resource attributes.
+type OrganizationSettingsRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+
+	BillingEmail    *string
+	Name            *string
+	Description     *string
+	Company         *string
+	Blog            *string
+	Email           *string
+	TwitterUsername *string
+	Location        *string
+
+	DefaultRepositoryPermission *string
+	WebCommitSignoffRequired    *string
+	HasOrganizationProjects     *string
+	HasRepositoryProjects       *string
+
+	MembersCanCreateRepositories         *string
+	MembersCanCreatePublicRepositories   *string
+	MembersCanCreatePrivateRepositories  *string
+	MembersCanCreateInternalRepositories *string
+	MembersCanCreatePages                *string
+	MembersCanCreatePublicPages          *string
+	MembersCanCreatePrivatePages         *string
+	MembersCanForkPrivateRepositories    *string
+
+	AdvancedSecurityEnabledForNewRepositories             *string
+	DependabotAlertsEnabledForNewRepositories             *string
+	DependabotSecurityUpdatesEnabledForNewRepositories    *string
+	DependencyGraphEnabledForNewRepositories              *string
+	SecretScanningEnabledForNewRepositories               *string
+	SecretScanningPushProtectionEnabledForNewRepositories *string
+}
+
+/** Public **/
+
+// NewOrganizationSettingsSignature returns the `github_organization_settings` terraform resource
+// as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewOrganizationSettingsSignature(res *OrganizationSettingsRes) *tfsig.BlockSignature {
+	if res == nil || res.BillingEmail == nil {
+		return nil
+	}
+
+	valGen := res.ValueGenerator
+	sig := tfsig.NewResource("github_organization_settings", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "billing_email", valGen.ToString(res.BillingEmail))
+	tfsig.AppendAttributeIfNotNil(sig, "name", valGen.ToString(res.Name))
+	tfsig.AppendAttributeIfNotNil(sig, "description", valGen.ToString(res.Description))
+	tfsig.AppendAttributeIfNotNil(sig, "company", valGen.ToString(res.Company))
+	tfsig.AppendAttributeIfNotNil(sig, "blog", valGen.ToString(res.Blog))
+	tfsig.AppendAttributeIfNotNil(sig, "email", valGen.ToString(res.Email))
+	tfsig.AppendAttributeIfNotNil(sig, "twitter_username", valGen.ToString(res.TwitterUsername))
+	tfsig.AppendAttributeIfNotNil(sig, "location", valGen.ToString(res.Location))
+
+	appendAttributeGroup(sig, []attributeValue{
+		{"default_repository_permission", valGen.ToString(res.DefaultRepositoryPermission)},
+		{"web_commit_signoff_required", valGen.ToBool(res.WebCommitSignoffRequired)},
+		{"has_organization_projects", valGen.ToBool(res.HasOrganizationProjects)},
+		{"has_repository_projects", valGen.ToBool(res.HasRepositoryProjects)},
+	})
+
+	appendAttributeGroup(sig, []attributeValue{
+		{"members_can_create_repositories", valGen.ToBool(res.MembersCanCreateRepositories)},
+		{"members_can_create_public_repositories", valGen.ToBool(res.MembersCanCreatePublicRepositories)},
+		{"members_can_create_private_repositories", valGen.ToBool(res.MembersCanCreatePrivateRepositories)},
+		{"members_can_create_internal_repositories", valGen.ToBool(res.MembersCanCreateInternalRepositories)},
+		{"members_can_create_pages", valGen.ToBool(res.MembersCanCreatePages)},
+		{"members_can_create_public_pages", valGen.ToBool(res.MembersCanCreatePublicPages)},
+		{"members_can_create_private_pages", valGen.ToBool(res.MembersCanCreatePrivatePages)},
+		{"members_can_fork_private_repositories", valGen.ToBool(res.MembersCanForkPrivateRepositories)},
+	})
+
+	appendAttributeGroup(sig, []attributeValue{
+		{
+			"advanced_security_enabled_for_new_repositories",
+			valGen.ToBool(res.AdvancedSecurityEnabledForNewRepositories),
+		},
+		{
+			"dependabot_alerts_enabled_for_new_repositories",
+			valGen.ToBool(res.DependabotAlertsEnabledForNewRepositories),
+		},
+		{
+			"dependabot_security_updates_enabled_for_new_repositories",
+			valGen.ToBool(res.DependabotSecurityUpdatesEnabledForNewRepositories),
+		},
+		{
+			"dependency_graph_enabled_for_new_repositories",
+			valGen.ToBool(res.DependencyGraphEnabledForNewRepositories),
+		},
+		{
+			"secret_scanning_enabled_for_new_repositories",
+			valGen.ToBool(res.SecretScanningEnabledForNewRepositories),
+		},
+		{
+			"secret_scanning_push_protection_enabled_for_new_repositories",
+			valGen.ToBool(res.SecretScanningPushProtectionEnabledForNewRepositories),
+		},
+	})
+
+	return sig
+}
@@ -88,16 +88,49 @@ func MapToRepositoryRes(repoConfig *GhRepoConfig, valGen tfsig.ValueGenerator, r
 		SquashMergeCommitMessage: squashMergeCommitMessage,
 		DeleteBranchOnMerge:      deleteBranchOnMerge,
 		ArchiveOnDestroy:         archiveOnDestroy,
-		// SuggestUpdate:            suggestUpdate,
-		// IsTemplate:               isTemplate,
-		// LicenseTemplate:          licenseTemplate
-		// GitignoreTemplate:        gitignoreTemplate
 
 		Page:     page,
 		Template: template,
 	}
 }
 
+// MapToRepositoryExtraRes maps `github_repository` attributes not managed by go-gh2tf.
+func MapToRepositoryExtraRes(repoConfig *GhRepoConfig, valGen tfsig.ValueGenerator) *RepositoryExtraRes {
+	if repoConfig == nil {
+		return nil
+	}
+
+	//nolint:exhaustruct // No need here, values are set below if provided
+	res := &RepositoryExtraRes{ValueGenerator: valGen}
+
+	if misc := repoConfig.Miscellaneous; misc != nil {
+		res.IsTemplate = misc.IsTemplate
+
+		if misc.FileTemplates != nil {
+			res.GitignoreTemplate = misc.FileTemplates.Gitignore
+			res.LicenseTemplate = misc.FileTemplates.License
+		}
+
+		if misc.Template != nil {
+			res.TemplateIncludeAllBranches = misc.Template.FullClone
+		}
+
+		if misc.Pages != nil {
+			res.PagesCname = misc.Pages.Domain
+		}
+	}
+
+	if repoConfig.PullRequests != nil && repoConfig.PullRequests.Branch != nil {
+		res.AllowUpdateBranch = repoConfig.PullRequests.Branch.SuggestUpdate
+	}
+
+	if repoConfig.Terraform != nil {
+		res.IgnoreVulnerabilityAlertsDuringRead = repoConfig.Terraform.IgnoreVulnerabilityAlertsDuringRead
+	}
+
+	return res
+}
+
 func MapToBranchRes(
 	name string,
 	branchConfig *GhBranchConfig,
@@ -316,13 +349,21 @@ func MapToBranchProtectionRes(
 		pushRestrictions = branchProtectionConfig.Pushes.RestrictTo
 	}
 
+	allowsDeletions := branchProtectionConfig.AllowDeletion
+
+	if isForbiddenBranchProtection(branchProtectionConfig) {
+		// Empty restriction means nobody is allowed to push, MapToBranchProtectionExtraRes also locks the branch
+		// and blocks creation of matching branches
+		allowsDeletions, allowsForcePushes, pushRestrictions = &falseString, &falseString, &[]string{}
+	}
+
 	return &ghbranchprotect.Config{
 		ValueGenerator:        valGen,
 		Identifier:            repoTfId + "-" + idEnd,
 		RepositoryId:          repoName,
 		Pattern:               pattern,
 		EnforceAdmins:         branchProtectionConfig.EnforceAdmins,
-		AllowsDeletions:       branchProtectionConfig.AllowDeletion,
+		AllowsDeletions:       allowsDeletions,
 		AllowsForcePushes:     allowsForcePushes,
 		PushRestrictions:      pushRestrictions,
 		RequiredLinearHistory: branchProtectionConfig.RequireLinearHistory,
@@ -332,6 +373,63 @@ func MapToBranchProtectionRes(
 	}
 }
 
+func MapBranchToBranchProtectionExtraRes(
+	protection *BaseGhBranchProtectionConfig,
+	valGen tfsig.ValueGenerator,
+	ctx *WorkspaceContext,
+) *BranchProtectionExtraRes {
+	if protection == nil {
+		return nil
+	}
+
+	wrapper := &GhBranchProtectionConfig{
+		Pattern:                      nil,
+		Forbid:                       &falseString,
+		BaseGhBranchProtectionConfig: *protection,
+	}
+
+	return MapToBranchProtectionExtraRes(wrapper, valGen, ctx)
+}
+
+// MapToBranchProtectionExtraRes maps attributes not managed by go-gh2tf, bypassers are resolved by
+// WorkspaceContext.BranchProtectionBypasser.
+func MapToBranchProtectionExtraRes(
+	branchProtectionConfig *GhBranchProtectionConfig,
+	valGen tfsig.ValueGenerator,
+	ctx *WorkspaceContext,
+) *BranchProtectionExtraRes {
+	if branchProtectionConfig == nil {
+		return nil
+	}
+
+	//nolint:exhaustruct // No need here, values are set below if provided
+	res := &BranchProtectionExtraRes{ValueGenerator: valGen}
+
+	if isForbiddenBranchProtection(branchProtectionConfig) {
+		trueString := "true"
+		res.LockBranch, res.BlocksCreations = &trueString, &trueString
+	}
+
+	if reviews := branchProtectionConfig.PullRequestReviews; reviews != nil {
+		res.RequireConversationResolution = reviews.ResolvedConversations
+
+		if reviews.Bypassers != nil {
+			bypassers := make([]string, len(*reviews.Bypassers))
+			for idx, bypasser := range *reviews.Bypassers {
+				bypassers[idx] = ctx.BranchProtectionBypasser(bypasser)
+			}
+
+			res.PullRequestBypassers = &bypassers
+		}
+	}
+
+	return res
+}
+
+func isForbiddenBranchProtection(config *GhBranchProtectionConfig) bool {
+	return config != nil && config.Forbid != nil && *config.Forbid == "true"
+}
+
 func mapBranchProtectionResLink(
 	link MapperLink,
 	repoTfId string,
@@ -386,7 +484,6 @@ func mapPullRequest(
 		squashMergeCommitMessage *string
 		deleteBranchOnMerge      *string
 	)
-	// var suggestUpdate *string
 
 	if repoConfig.PullRequests != nil {
 		if repoConfig.PullRequests.MergeStrategy != nil {
@@ -407,7 +504,6 @@ func mapPullRequest(
 		}
 
 		if repoConfig.PullRequests.Branch != nil {
-			// suggestUpdate = c.PullRequests.Branch.SuggestUpdate
 			deleteBranchOnMerge = repoConfig.PullRequests.Branch.DeleteOnMerge
 		}
 	}
@@ -422,9 +518,6 @@ func mapMiscellaneous(repoConfig *GhRepoConfig, valGen tfsig.ValueGenerator) (
 	autoInit *string,
 	archived *string,
 	homepageUrl *string,
-	// isTemplate *string,
-	// gitignoreTemplate *string,
-	// licenseTemplate *string,
 	hasIssues *string,
 	hasProjects *string,
 	hasWiki *string,
@@ -437,17 +530,11 @@ func mapMiscellaneous(repoConfig *GhRepoConfig, valGen tfsig.ValueGenerator) (
 		autoInit = repoConfig.Miscellaneous.AutoInit
 		archived = repoConfig.Miscellaneous.Archived
 		homepageUrl = repoConfig.Miscellaneous.HomepageUrl
-		// isTemplate = c.Miscellaneous.IsTemplate
 		hasIssues = repoConfig.Miscellaneous.HasIssues
 		hasProjects = repoConfig.Miscellaneous.HasProjects
 		hasWiki = repoConfig.Miscellaneous.HasWiki
 		hasDownloads = repoConfig.Miscellaneous.HasDownloads
 
-		// if c.Miscellaneous.FileTemplates != nil {
-		// gitignoreTemplate = c.Miscellaneous.FileTemplates.Gitignore
-		// licenseTemplate = c.Miscellaneous.FileTemplates.License
-		// }
-
 		template = mapTemplate(repoConfig, valGen)
 		page = mapPage(repoConfig, valGen)
 	}
@@ -476,9 +563,8 @@ func mapTemplate(repoConfig *GhRepoConfig, valGen tfsig.ValueGenerator) *ghrepos
 	if repoConfig.Miscellaneous.Template != nil {
 		template := &ghrepository.TemplateConfig{
 			ValueGenerator: valGen,
-			// IncludeAllBranches: c.Miscellaneous.Template.FullClone,
-			Owner:      nil,
-			Repository: nil,
+			Owner:          nil,
+			Repository:     nil,
 		}
 
 		if repoConfig.Miscellaneous.Template.Source != nil {
@@ -1,10 +1,15 @@
 package core_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
 
 	"github.com/yoanm/go-gh2tf"
 	"github.com/yoanm/go-gh2tf/ghbranch"
@@ -490,6 +495,7 @@ func TestMapToBranchProtectionRes(t *testing.T) {
 	repoName := "a_repo"
 	pattern := "a-pattern"
 	tfId := "an_id"
+	trueString, falseString := "true", "false"
 	repoLink := fmt.Sprintf("github_repository.%s.node_id", tfId)
 	branchLink := fmt.Sprintf("github_branch.%s-%s.branch", tfId, pattern)
 	repoConfig := core.GhRepoConfig{
@@ -561,6 +567,65 @@ func TestMapToBranchProtectionRes(t *testing.T) {
 				RequiredPRReview:      nil,
 			},
 		},
+		"forbidden": {
+			&core.GhBranchProtectionConfig{
+				Pattern: &pattern,
+				Forbid:  &trueString,
+				BaseGhBranchProtectionConfig: core.BaseGhBranchProtectionConfig{
+					EnforceAdmins: &trueString,
+					AllowDeletion: &trueString,
+					Pushes: &core.GhBranchProtectPushesConfig{
+						AllowsForcePushes: &trueString,
+						RestrictTo:        &[]string{"a-team"},
+					},
+				},
+			},
+			&repoConfig,
+			nil,
+			&ghbranchprotect.Config{
+				ValueGenerator:        valGen,
+				Identifier:            tfId + "-" + pattern,
+				RepositoryId:          &repoName,
+				Pattern:               &pattern,
+				EnforceAdmins:         &trueString,
+				AllowsDeletions:       &falseString,
+				AllowsForcePushes:     &falseString,
+				PushRestrictions:      &[]string{},
+				RequiredLinearHistory: nil,
+				RequireSignedCommits:  nil,
+				RequiredStatusChecks:  nil,
+				RequiredPRReview:      nil,
+			},
+		},
+		"not forbidden": {
+			&core.GhBranchProtectionConfig{
+				Pattern: &pattern,
+				Forbid:  &falseString,
+				BaseGhBranchProtectionConfig: core.BaseGhBranchProtectionConfig{
+					AllowDeletion: &trueString,
+					Pushes: &core.GhBranchProtectPushesConfig{
+						AllowsForcePushes: &trueString,
+						RestrictTo:        &[]string{"a-team"},
+					},
+				},
+			},
+			&repoConfig,
+			nil,
+			&ghbranchprotect.Config{
+				ValueGenerator:        valGen,
+				Identifier:            tfId + "-" + pattern,
+				RepositoryId:          &repoName,
+				Pattern:               &pattern,
+				EnforceAdmins:         nil,
+				AllowsDeletions:       &trueString,
+				AllowsForcePushes:     &trueString,
+				PushRestrictions:      &[]string{"a-team"},
+				RequiredLinearHistory: nil,
+				RequireSignedCommits:  nil,
+				RequiredStatusChecks:  nil,
+				RequiredPRReview:      nil,
+			},
+		},
 	}
 
 	diffOpts := []cmp.Option{cmp.AllowUnexported(tfsig.ValueGenerator{}), cmp.AllowUnexported(tfsig.IdentTokenMatcher{})}
@@ -595,3 +660,499 @@ func TestMapToBranchProtectionRes_panic(t *testing.T) {
 		"repository name is mandatory for branch protection config",
 	)
 }
+
+func TestMapToBranchProtectionExtraRes(t *testing.T) {
+	t.Parallel()
+
+	valGen := gh2tf.NewValueGenerator()
+	trueString, falseString := "true", "false"
+	teamName := "a-team"
+	ctx := &core.WorkspaceContext{TeamTfIds: map[string]string{teamName: "a_team"}}
+	cases := map[string]struct {
+		value    *core.GhBranchProtectionConfig
+		expected *core.BranchProtectionExtraRes
+	}{
+		"nil": {
+			nil,
+			nil,
+		},
+		"empty": {
+			&core.GhBranchProtectionConfig{},
+			&core.BranchProtectionExtraRes{ValueGenerator: valGen},
+		},
+		"forbidden": {
+			&core.GhBranchProtectionConfig{Forbid: &trueString},
+			&core.BranchProtectionExtraRes{ValueGenerator: valGen, LockBranch: &trueString, BlocksCreations: &trueString},
+		},
+		"not forbidden": {
+			&core.GhBranchProtectionConfig{Forbid: &falseString},
+			&core.BranchProtectionExtraRes{ValueGenerator: valGen},
+		},
+		"pull request reviews": {
+			&core.GhBranchProtectionConfig{
+				BaseGhBranchProtectionConfig: core.BaseGhBranchProtectionConfig{
+					PullRequestReviews: &core.GhBranchProtectPRReviewConfig{
+						Bypassers:             &[]string{"a-user", "/another-user", "@" + teamName, "@unknown-team", "an-org/a-team"},
+						ResolvedConversations: &trueString,
+					},
+				},
+			},
+			&core.BranchProtectionExtraRes{
+				ValueGenerator:                valGen,
+				RequireConversationResolution: &trueString,
+				PullRequestBypassers: &[]string{
+					"/a-user",
+					"/another-user",
+					"github_team.a_team.node_id",
+					"@unknown-team",
+					"an-org/a-team",
+				},
+			},
+		},
+	}
+
+	diffOpts := []cmp.Option{cmp.AllowUnexported(tfsig.ValueGenerator{}), cmp.AllowUnexported(tfsig.IdentTokenMatcher{})}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				actual := core.MapToBranchProtectionExtraRes(tc.value, valGen, ctx)
+				if diff := cmp.Diff(tc.expected, actual, diffOpts...); diff != "" {
+					t.Errorf("Config mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
+
+func TestNewBranchProtectionSignature(t *testing.T) {
+	t.Parallel()
+
+	valGen := gh2tf.NewValueGenerator()
+	repoName := "a_repo"
+	pattern := "a-pattern"
+	trueString := "true"
+	res := &ghbranchprotect.Config{
+		ValueGenerator: valGen,
+		Identifier:     "an_id-a-pattern",
+		RepositoryId:   &repoName,
+		Pattern:        &pattern,
+		RequiredStatusChecks: &ghbranchprotect.RequiredStatusChecksConfig{
+			ValueGenerator: valGen,
+			Contexts:       &[]string{"a-context"},
+		},
+	}
+	cases := map[string]struct {
+		value    *ghbranchprotect.Config
+		extra    *core.BranchProtectionExtraRes
+		expected string
+	}{
+		"nil": {
+			nil,
+			&core.BranchProtectionExtraRes{ValueGenerator: valGen, LockBranch: &trueString},
+			"",
+		},
+		"without extra attributes": {
+			res,
+			nil,
+			`resource "github_branch_protection" "an_id-a-pattern" {
+  repository_id = "a_repo"
+  pattern       = "a-pattern"
+
+  required_status_checks {
+    contexts = ["a-context"]
+  }
+}
+`,
+		},
+		"with extra attributes": {
+			res,
+			&core.BranchProtectionExtraRes{
+				ValueGenerator:                valGen,
+				LockBranch:                    &trueString,
+				BlocksCreations:               &trueString,
+				RequireConversationResolution: &trueString,
+				PullRequestBypassers:          &[]string{"/a-user", "github_team.a_team.node_id"},
+			},
+			`resource "github_branch_protection" "an_id-a-pattern" {
+  repository_id                   = "a_repo"
+  pattern                         = "a-pattern"
+  require_conversation_resolution = true
+  lock_branch                     = true
+  blocks_creations                = true
+
+  required_status_checks {
+    contexts = ["a-context"]
+  }
+
+  required_pull_request_reviews {
+    pull_request_bypassers = ["/a-user", github_team.a_team.node_id]
+  }
+}
+`,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				hclFile := hclwrite.NewEmptyFile()
+				if sig := core.NewBranchProtectionSignature(tc.value, tc.extra); sig != nil {
+					hclFile.Body().AppendBlock(sig.Build())
+				}
+
+				if err := testutils.EnsureFileContentEquals(hclFile, tc.expected); err != nil {
+					t.Errorf("Case %q: %s", tcname, err)
+				}
+			},
+		)
+	}
+}
+
+// TestNewBranchProtectionSignature_forbidden ensures forbidden branch protections lock the branch and block creations
+// alongside an empty push restriction list, whatever the provided config allows.
+func TestNewBranchProtectionSignature_forbidden(t *testing.T) {
+	t.Parallel()
+
+	valGen := gh2tf.NewValueGenerator()
+	repoName := "a_repo"
+	pattern := "a-pattern"
+	trueString := "true"
+	config := &core.GhBranchProtectionConfig{
+		Pattern: &pattern,
+		Forbid:  &trueString,
+		BaseGhBranchProtectionConfig: core.BaseGhBranchProtectionConfig{
+			EnforceAdmins: &trueString,
+			Pushes:        &core.GhBranchProtectPushesConfig{AllowsForcePushes: &trueString},
+		},
+	}
+	repoConfig := &core.GhRepoConfig{Name: &repoName}
+
+	hclFile := hclwrite.NewEmptyFile()
+	hclFile.Body().AppendBlock(
+		core.NewBranchProtectionSignature(
+			core.MapToBranchProtectionRes(config, valGen, repoConfig, "an_id"),
+			core.MapToBranchProtectionExtraRes(config, valGen, core.NewWorkspaceContext(core.NewConfig())),
+		).Build(),
+	)
+
+	expected := `resource "github_branch_protection" "an_id-a-pattern" {
+  repository_id       = "a_repo"
+  pattern             = "a-pattern"
+  enforce_admins      = true
+  allows_deletions    = false
+  allows_force_pushes = false
+  push_restrictions   = []
+  lock_branch         = true
+  blocks_creations    = true
+}
+`
+	if err := testutils.EnsureFileContentEquals(hclFile, expected); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewRepositorySignature(t *testing.T) {
+	t.Parallel()
+
+	repoName := "a_repo"
+	trueString := "true"
+	domain := "a.domain"
+	templateSource := "an-owner/a-template"
+	cases := map[string]struct {
+		value    *core.GhRepoConfig
+		expected string
+	}{
+		"Pages domain only": {
+			&core.GhRepoConfig{
+				Name: &repoName,
+				Miscellaneous: &core.GhRepoMiscellaneousConfig{
+					IsTemplate: &trueString,
+					Pages:      &core.GhRepoPagesConfig{Domain: &domain},
+				},
+			},
+			`resource "github_repository" "an_id" {
+  name = "a_repo"
+
+  pages {
+    cname = "a.domain"
+  }
+
+  is_template = true
+}
+`,
+		},
+		"Full clone without template source": {
+			&core.GhRepoConfig{
+				Name: &repoName,
+				Miscellaneous: &core.GhRepoMiscellaneousConfig{
+					Template: &core.GhRepoTemplateConfig{FullClone: &trueString},
+				},
+			},
+			`resource "github_repository" "an_id" {
+  name = "a_repo"
+}
+`,
+		},
+		"Full clone with template source": {
+			&core.GhRepoConfig{
+				Name: &repoName,
+				Miscellaneous: &core.GhRepoMiscellaneousConfig{
+					Template: &core.GhRepoTemplateConfig{Source: &templateSource, FullClone: &trueString},
+				},
+				PullRequests: &core.GhRepoPullRequestConfig{
+					Branch: &core.GhRepoPRBranchConfig{SuggestUpdate: &trueString},
+				},
+			},
+			`resource "github_repository" "an_id" {
+  name = "a_repo"
+
+  template {
+    owner                = "an-owner"
+    repository           = "a-template"
+    include_all_branches = true
+  }
+
+  allow_update_branch = true
+}
+`,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				hclFile := hclwrite.NewEmptyFile()
+				if sig := core.NewRepositorySignature(tc.value, gh2tf.NewValueGenerator(), "an_id"); sig != nil {
+					hclFile.Body().AppendBlock(sig.Build())
+				}
+
+				if err := testutils.EnsureFileContentEquals(hclFile, tc.expected); err != nil {
+					t.Errorf("Case %q: %s", tcname, err)
+				}
+			},
+		)
+	}
+}
+
+func TestMapToRepositoryFileResList(t *testing.T) {
+	t.Parallel()
+
+	repoName := "a_repo"
+	cases := map[string]struct {
+		filePath        string
+		expectedContent string
+	}{
+		"basic": {
+			"docs/a-file.md",
+			`file("${path.module}/../files/docs/a-file.md")`,
+		},
+		"with HCL syntax": {
+			`docs/"a" ${file} %{if} \file.md`,
+			`file("${path.module}/../files/docs/\"a\" $${file} %%{if} \\file.md")`,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				repoConfig := &core.GhRepoConfig{Name: &repoName, Files: &core.GhRepoFilesConfig{tc.filePath: nil}}
+				resList := core.MapToRepositoryFileResList(repoConfig, gh2tf.NewValueGenerator(), "an_id")
+
+				if len(resList) != 1 {
+					t.Fatalf("Expected 1 resource, got %d", len(resList))
+				}
+
+				if diff := cmp.Diff(tc.expectedContent, *resList[0].Content); diff != "" {
+					t.Errorf("Content mismatch (-want +got):\n%s", diff)
+				}
+
+				// Source path must remain the same once parsed
+				expr, diags := hclsyntax.ParseExpression([]byte(*resList[0].Content), "", hcl.InitialPos)
+				if diags.HasErrors() {
+					t.Fatal(diags)
+				}
+
+				template, _ := expr.(*hclsyntax.FunctionCallExpr).Args[0].(*hclsyntax.TemplateExpr)
+				if template == nil || len(template.Parts) != 2 {
+					t.Fatalf("Expected path.module followed by a literal path, got %#v", template)
+				}
+
+				value, _ := template.Parts[1].Value(nil)
+				if diff := cmp.Diff("/../files/"+tc.filePath, value.AsString()); diff != "" {
+					t.Errorf("Source path mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
+
+// TestNewHclRepository_repositorySchemaCoverage ensures that every property of the repository schema sections
+// below reaches the terraform attribute it is mapped to.
+func TestNewHclRepository_repositorySchemaCoverage(t *testing.T) {
+	t.Parallel()
+
+	repoBlock := "resource.github_repository.repo1"
+	codeScanningBlock := "resource.terraform_data.repo1-code-scanning"
+	reportingBlock := "resource.terraform_data.repo1-private-vulnerability-reporting"
+	dependabotBlock := "resource.github_repository_dependabot_security_updates.repo1"
+	analysis := "security_and_analysis."
+	// Property path => block address, attribute path inside the block and, optionally, expected expression content
+	expected := map[string]hclAttributeLocation{
+		"visibility":                              {repoBlock, "visibility", ""},
+		"description":                             {repoBlock, "description", ""},
+		"misc.auto-init":                          {repoBlock, "auto_init", ""},
+		"misc.archived":                           {repoBlock, "archived", ""},
+		"misc.is-template":                        {repoBlock, "is_template", ""},
+		"misc.topics":                             {repoBlock, "topics", ""},
+		"misc.homepage-url":                       {repoBlock, "homepage_url", ""},
+		"misc.issues":                             {repoBlock, "has_issues", ""},
+		"misc.wiki":                               {repoBlock, "has_wiki", ""},
+		"misc.projects":                           {repoBlock, "has_projects", ""},
+		"misc.downloads":                          {repoBlock, "has_downloads", ""},
+		"misc.template.source":                    {repoBlock, "template.repository", ""},
+		"misc.template.full-clone":                {repoBlock, "template.include_all_branches", ""},
+		"misc.pages.domain":                       {repoBlock, "pages.cname", ""},
+		"misc.pages.source-branch":                {repoBlock, "pages.source.branch", ""},
+		"misc.pages.source-path":                  {repoBlock, "pages.source.path", ""},
+		"misc.file-templates.gitignore":           {repoBlock, "gitignore_template", ""},
+		"misc.file-templates.license":             {repoBlock, "license_template", ""},
+		"pull-requests.merge-strategy.merge":      {repoBlock, "allow_merge_commit", ""},
+		"pull-requests.merge-strategy.rebase":     {repoBlock, "allow_rebase_merge", ""},
+		"pull-requests.merge-strategy.squash":     {repoBlock, "allow_squash_merge", ""},
+		"pull-requests.merge-strategy.auto-merge": {repoBlock, "allow_auto_merge", ""},
+		"pull-requests.merge-commit.title":        {repoBlock, "merge_commit_title", ""},
+		"pull-requests.merge-commit.message":      {repoBlock, "merge_commit_message", ""},
+		"pull-requests.squash-commit.title":       {repoBlock, "squash_merge_commit_title", ""},
+		"pull-requests.squash-commit.message":     {repoBlock, "squash_merge_commit_message", ""},
+		"pull-requests.branch.suggest-update":     {repoBlock, "allow_update_branch", ""},
+		"pull-requests.branch.delete-on-merge":    {repoBlock, "delete_branch_on_merge", ""},
+		"security.vulnerability-alerts":           {repoBlock, "vulnerability_alerts", ""},
+		"security.advanced-security":              {repoBlock, analysis + "advanced_security.status", ""},
+		"security.secret-scanning":                {repoBlock, analysis + "secret_scanning.status", ""},
+		"security.secret-scanning-push-protection": {
+			repoBlock, analysis + "secret_scanning_push_protection.status", "",
+		},
+		"security.dependabot-security-updates":              {dependabotBlock, "enabled", ""},
+		"security.private-vulnerability-reporting":          {reportingBlock, "triggers_replace", ""},
+		"security.code-scanning.state":                      {codeScanningBlock, "triggers_replace", "state="},
+		"security.code-scanning.query-suite":                {codeScanningBlock, "triggers_replace", "query_suite="},
+		"security.code-scanning.languages":                  {codeScanningBlock, "triggers_replace", "languages[]="},
+		"terraform.archive-on-destroy":                      {repoBlock, "archive_on_destroy", ""},
+		"terraform.ignore-vulnerability-alerts-during-read": {repoBlock, "ignore_vulnerability_alerts_during_read", ""},
+		"terraform.previous-names":                          {"moved", "from", "github_repository.old-repo1"},
+	}
+
+	content, err := core.Schemas.FindContent("map:///repo-template.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var schema repositorySchema
+	if err = json.Unmarshal([]byte(*content), &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	hclFile, diags := hclwrite.ParseConfig(
+		core.NewHclRepository("repo1", GetFullConfig(1), gh2tf.NewValueGenerator()).Bytes(),
+		"repo1.tf",
+		hcl.InitialPos,
+	)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	for _, section := range []string{"visibility", "description", "misc", "pull-requests", "security", "terraform"} {
+		for _, path := range schema.leafPaths(section, schema.Definitions["Root"].Properties[section]) {
+			location, ok := expected[path]
+
+			switch {
+			case !ok:
+				t.Errorf("schema property %q is not covered", path)
+			case !location.existsIn(hclFile.Body()):
+				t.Errorf("schema property %q doesn't reach the HCL (expected %+v)", path, location)
+			}
+		}
+	}
+}
+
+type hclAttributeLocation struct {
+	// Block is the block type followed by its labels, joined with "."
+	Block string
+	// Attribute is the attribute name, prefixed by nested block types joined with "."
+	Attribute string
+	// Contains is a string the attribute expression must contain, if not empty
+	Contains string
+}
+
+// existsIn returns true if one of the matching top level blocks of the body contains the attribute.
+func (l hclAttributeLocation) existsIn(body *hclwrite.Body) bool {
+	for _, block := range body.Blocks() {
+		if strings.Join(append([]string{block.Type()}, block.Labels()...), ".") != l.Block {
+			continue
+		}
+
+		blockBody := block.Body()
+		pathItems := strings.Split(l.Attribute, ".")
+
+		for _, blockType := range pathItems[:len(pathItems)-1] {
+			if nestedBlock := blockBody.FirstMatchingBlock(blockType, nil); nestedBlock != nil {
+				blockBody = nestedBlock.Body()
+			} else {
+				blockBody = nil
+
+				break
+			}
+		}
+
+		if blockBody == nil {
+			continue
+		}
+
+		attribute := blockBody.GetAttribute(pathItems[len(pathItems)-1])
+		if attribute != nil && strings.Contains(string(attribute.Expr().BuildTokens(nil).Bytes()), l.Contains) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type repositorySchemaProperty struct {
+	Ref        string                               `json:"$ref"`
+	Properties map[string]*repositorySchemaProperty `json:"properties"`
+}
+
+type repositorySchema struct {
+	Definitions map[string]*repositorySchemaProperty `json:"definitions"`
+}
+
+// leafPaths returns the path of every property without sub-properties, references to definitions are followed.
+func (s *repositorySchema) leafPaths(path string, property *repositorySchemaProperty) []string {
+	if ref, found := strings.CutPrefix(property.Ref, "#/definitions/"); found {
+		property = s.Definitions[ref]
+	}
+
+	if len(property.Properties) == 0 {
+		return []string{path}
+	}
+
+	paths := []string{}
+	for name, subProperty := range property.Properties {
+		paths = append(paths, s.leafPaths(path+"."+name, subProperty)...)
+	}
+
+	return paths
+}
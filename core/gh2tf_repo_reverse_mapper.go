@@ -0,0 +1,377 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+const (
+	repositoryResourceType       = "github_repository"
+	defaultBranchResourceType    = "github_branch_default"
+	branchResourceType           = "github_branch"
+	branchProtectionResourceType = "github_branch_protection"
+)
+
+/** Public **/
+
+// MapStateToRepoConfigs is the reverse of MapToRepositoryRes, MapToDefaultBranchRes, MapToBranchRes and
+// MapToBranchProtectionRes: it builds repository configs from github resources found in a terraform state.
+//
+// Only properties managed by the mappers are taken into account, and values equal to provider defaults are omitted.
+// Returned configs are sorted by repository name.
+func MapStateToRepoConfigs(resources []*TerraformStateResource) ([]*GhRepoConfig, error) {
+	repoList := map[string]*GhRepoConfig{}
+	// Branch protections refer to repositories by node ID or by name
+	repoIdList := map[string]*GhRepoConfig{}
+
+	for _, res := range resourcesByType(resources, repositoryResourceType) {
+		repo := mapStateToRepoConfig(res.Attributes)
+		if repo.Name == nil {
+			continue
+		}
+
+		repoList[*repo.Name] = repo
+		repoIdList[*repo.Name] = repo
+
+		if nodeId := stateValue(res.Attributes, "node_id"); nodeId != nil {
+			repoIdList[*nodeId] = repo
+		}
+	}
+
+	errList := []error{}
+
+	for _, res := range resourcesByType(resources, defaultBranchResourceType) {
+		repo, err := findStateRepository(repoList, res, "repository")
+		if err != nil {
+			errList = append(errList, err)
+
+			continue
+		}
+
+		//nolint:exhaustruct // No need here, simple init
+		repo.DefaultBranch = &GhDefaultBranchConfig{Name: stateValue(res.Attributes, "branch")}
+	}
+
+	for _, res := range resourcesByType(resources, branchResourceType) {
+		repo, err := findStateRepository(repoList, res, "repository")
+		if err != nil {
+			errList = append(errList, err)
+
+			continue
+		}
+
+		mapStateToBranchConfig(repo, res.Attributes)
+	}
+
+	protectionList := resourcesByType(resources, branchProtectionResourceType)
+	sort.SliceStable(protectionList, func(i, j int) bool {
+		return fmt.Sprint(protectionList[i].Attributes["pattern"]) < fmt.Sprint(protectionList[j].Attributes["pattern"])
+	})
+
+	for _, res := range protectionList {
+		repo, err := findStateRepository(repoIdList, res, "repository_id")
+		if err != nil {
+			errList = append(errList, err)
+
+			continue
+		}
+
+		mapStateToBranchProtectionConfig(repo, res.Attributes)
+	}
+
+	if len(errList) > 0 {
+		return nil, ReverseMappingError(errList)
+	}
+
+	return MapToSortedList(repoList), nil
+}
+
+/** Private **/
+
+func resourcesByType(resources []*TerraformStateResource, resType string) []*TerraformStateResource {
+	list := []*TerraformStateResource{}
+
+	for _, res := range resources {
+		if res.Type == resType {
+			list = append(list, res)
+		}
+	}
+
+	return list
+}
+
+func findStateRepository(
+	repoList map[string]*GhRepoConfig,
+	res *TerraformStateResource,
+	attribute string,
+) (*GhRepoConfig, error) {
+	repoId := stateValue(res.Attributes, attribute)
+	if repoId != nil {
+		if repo, ok := repoList[*repoId]; ok {
+			return repo, nil
+		}
+	}
+
+	return nil, StateRepositoryNotFoundError(res.Type+"."+res.Name, repoId)
+}
+
+//nolint:exhaustruct // No need here, only non-default properties are set
+func mapStateToRepoConfig(attrs map[string]interface{}) *GhRepoConfig {
+	repo := &GhRepoConfig{
+		Name:        stateValue(attrs, "name"),
+		Visibility:  stateValue(attrs, "visibility"),
+		Description: stateValue(attrs, "description"),
+	}
+
+	misc := &GhRepoMiscellaneousConfig{
+		Topics:       stateStringList(attrs, "topics"),
+		AutoInit:     stateValue(attrs, "auto_init", falseString),
+		Archived:     stateValue(attrs, "archived", falseString),
+		IsTemplate:   stateValue(attrs, "is_template", falseString),
+		HomepageUrl:  stateValue(attrs, "homepage_url"),
+		HasIssues:    stateValue(attrs, "has_issues", falseString),
+		HasWiki:      stateValue(attrs, "has_wiki", falseString),
+		HasProjects:  stateValue(attrs, "has_projects", falseString),
+		HasDownloads: stateValue(attrs, "has_downloads", falseString),
+	}
+
+	if template := stateBlock(attrs, "template"); template != nil {
+		owner, repository := stateValue(template, "owner"), stateValue(template, "repository")
+		if owner != nil && repository != nil {
+			source := *owner + "/" + *repository
+			misc.Template = &GhRepoTemplateConfig{
+				Source:    &source,
+				FullClone: stateValue(template, "include_all_branches", falseString),
+			}
+		}
+	}
+
+	if pages := stateBlock(attrs, "pages"); pages != nil {
+		//nolint:exhaustruct // No need here, source values are set below if provided
+		pagesConfig := &GhRepoPagesConfig{Domain: stateValue(pages, "cname")}
+		if source := stateBlock(pages, "source"); source != nil {
+			pagesConfig.SourceBranch = stateValue(source, "branch")
+			pagesConfig.SourcePath = stateValue(source, "path")
+		}
+
+		if !isEmptyStruct(pagesConfig) {
+			misc.Pages = pagesConfig
+		}
+	}
+
+	if !isEmptyStruct(misc) {
+		repo.Miscellaneous = misc
+	}
+
+	if pullRequests := mapStateToPullRequestConfig(attrs); pullRequests != nil {
+		repo.PullRequests = pullRequests
+	}
+
+	if vulnerabilityAlerts := stateValue(attrs, "vulnerability_alerts", falseString); vulnerabilityAlerts != nil {
+		repo.Security = &GhRepoSecurityConfig{VulnerabilityAlerts: vulnerabilityAlerts}
+	}
+
+	if archiveOnDestroy := stateValue(attrs, "archive_on_destroy", falseString); archiveOnDestroy != nil {
+		repo.Terraform = &GhRepoTerraformConfig{ArchiveOnDestroy: archiveOnDestroy}
+	}
+
+	return repo
+}
+
+//nolint:exhaustruct // No need here, only non-default properties are set
+func mapStateToPullRequestConfig(attrs map[string]interface{}) *GhRepoPullRequestConfig {
+	pullRequests := &GhRepoPullRequestConfig{}
+
+	mergeStrategy := &GhRepoPRMergeStrategyConfig{
+		AllowMerge:     stateValue(attrs, "allow_merge_commit", "true"),
+		AllowRebase:    stateValue(attrs, "allow_rebase_merge", "true"),
+		AllowSquash:    stateValue(attrs, "allow_squash_merge", "true"),
+		AllowAutoMerge: stateValue(attrs, "allow_auto_merge", falseString),
+	}
+	if !isEmptyStruct(mergeStrategy) {
+		pullRequests.MergeStrategy = mergeStrategy
+	}
+
+	mergeCommit := &GhRepoPRCommitConfig{
+		Title:   stateValue(attrs, "merge_commit_title", "MERGE_MESSAGE"),
+		Message: stateValue(attrs, "merge_commit_message", "PR_TITLE"),
+	}
+	if !isEmptyStruct(mergeCommit) {
+		pullRequests.MergeCommit = mergeCommit
+	}
+
+	squashCommit := &GhRepoPRCommitConfig{
+		Title:   stateValue(attrs, "squash_merge_commit_title", "COMMIT_OR_PR_TITLE"),
+		Message: stateValue(attrs, "squash_merge_commit_message", "COMMIT_MESSAGES"),
+	}
+	if !isEmptyStruct(squashCommit) {
+		pullRequests.SquashCommit = squashCommit
+	}
+
+	branch := &GhRepoPRBranchConfig{
+		SuggestUpdate: stateValue(attrs, "allow_update_branch", falseString),
+		DeleteOnMerge: stateValue(attrs, "delete_branch_on_merge", falseString),
+	}
+	if !isEmptyStruct(branch) {
+		pullRequests.Branch = branch
+	}
+
+	if isEmptyStruct(pullRequests) {
+		return nil
+	}
+
+	return pullRequests
+}
+
+func mapStateToBranchConfig(repo *GhRepoConfig, attrs map[string]interface{}) {
+	name := stateValue(attrs, "branch")
+	if name == nil {
+		return
+	}
+
+	if repo.Branches == nil {
+		repo.Branches = &GhBranchesConfig{}
+	}
+
+	// source_sha is computed by the provider, and source branch is useless if it's the default branch
+	sourceBranch := stateValue(attrs, "source_branch")
+	if sourceBranch != nil && repo.DefaultBranch != nil && repo.DefaultBranch.Name != nil &&
+		*sourceBranch == *repo.DefaultBranch.Name {
+		sourceBranch = nil
+	}
+
+	//nolint:exhaustruct // No need here, simple init
+	(*repo.Branches)[*name] = &GhBranchConfig{SourceBranch: sourceBranch}
+}
+
+func mapStateToBranchProtectionConfig(repo *GhRepoConfig, attrs map[string]interface{}) {
+	pattern := stateValue(attrs, "pattern")
+	if pattern == nil {
+		return
+	}
+
+	protection := mapStateToBaseBranchProtectionConfig(attrs)
+
+	switch {
+	case repo.DefaultBranch != nil && repo.DefaultBranch.Name != nil && *repo.DefaultBranch.Name == *pattern:
+		repo.DefaultBranch.Protection = protection
+	case repo.Branches != nil && (*repo.Branches)[*pattern] != nil:
+		(*repo.Branches)[*pattern].Protection = protection
+	default:
+		if repo.BranchProtections == nil {
+			repo.BranchProtections = &GhBranchProtectionsConfig{}
+		}
+
+		//nolint:exhaustruct // No need here, simple init
+		*repo.BranchProtections = append(
+			*repo.BranchProtections,
+			&GhBranchProtectionConfig{
+				Pattern:                      pattern,
+				Forbid:                       stateValue(attrs, "lock_branch", falseString),
+				BaseGhBranchProtectionConfig: *protection,
+			},
+		)
+	}
+}
+
+//nolint:exhaustruct // No need here, only non-default properties are set
+func mapStateToBaseBranchProtectionConfig(attrs map[string]interface{}) *BaseGhBranchProtectionConfig {
+	protection := &BaseGhBranchProtectionConfig{
+		EnforceAdmins:        stateValue(attrs, "enforce_admins", falseString),
+		AllowDeletion:        stateValue(attrs, "allows_deletions", falseString),
+		RequireLinearHistory: stateValue(attrs, "required_linear_history", falseString),
+		RequireSignedCommits: stateValue(attrs, "require_signed_commits", falseString),
+	}
+
+	pushes := &GhBranchProtectPushesConfig{
+		AllowsForcePushes: stateValue(attrs, "allows_force_pushes", falseString),
+		RestrictTo:        stateStringList(attrs, "push_restrictions"),
+	}
+	if !isEmptyStruct(pushes) {
+		protection.Pushes = pushes
+	}
+
+	if statusChecks := stateBlock(attrs, "required_status_checks"); statusChecks != nil {
+		protection.StatusChecks = &GhBranchProtectStatusChecksConfig{
+			Strict:   stateValue(statusChecks, "strict", falseString),
+			Required: stateStringList(statusChecks, "contexts"),
+		}
+	}
+
+	if reviews := stateBlock(attrs, "required_pull_request_reviews"); reviews != nil {
+		protection.PullRequestReviews = &GhBranchProtectPRReviewConfig{
+			CodeownerApprovals: stateValue(reviews, "require_code_owner_reviews", falseString),
+			ApprovalCount:      stateValue(reviews, "required_approving_review_count", "1"),
+		}
+
+		dismissals := &GhBranchProtectPRReviewDismissalsConfig{
+			Staled:     stateValue(reviews, "dismiss_stale_reviews", falseString),
+			Restrict:   stateValue(reviews, "restrict_dismissals", falseString),
+			RestrictTo: stateStringList(reviews, "dismissal_restrictions"),
+		}
+		if !isEmptyStruct(dismissals) {
+			protection.PullRequestReviews.Dismissals = dismissals
+		}
+	}
+
+	return protection
+}
+
+// stateValue returns the attribute value as string, nil if it doesn't exist, is empty or is one of omitted values.
+func stateValue(attrs map[string]interface{}, name string, omitted ...string) *string {
+	var value string
+
+	switch typed := attrs[name].(type) {
+	case string:
+		value = typed
+	case bool:
+		value = strconv.FormatBool(typed)
+	case float64:
+		value = strconv.FormatFloat(typed, 'f', -1, 64)
+	default:
+		return nil
+	}
+
+	if value == "" {
+		return nil
+	}
+
+	for _, omittedValue := range omitted {
+		if value == omittedValue {
+			return nil
+		}
+	}
+
+	return &value
+}
+
+// stateStringList returns the attribute value as string list, nil if it doesn't exist or is empty.
+func stateStringList(attrs map[string]interface{}, name string) *[]string {
+	rawList, ok := attrs[name].([]interface{})
+	if !ok || len(rawList) == 0 {
+		return nil
+	}
+
+	list := make([]string, 0, len(rawList))
+
+	for _, item := range rawList {
+		if value, isString := item.(string); isString {
+			list = append(list, value)
+		}
+	}
+
+	return &list
+}
+
+// stateBlock returns the first item of a nested block attribute, nil if there is none.
+func stateBlock(attrs map[string]interface{}, name string) map[string]interface{} {
+	rawList, ok := attrs[name].([]interface{})
+	if !ok || len(rawList) == 0 {
+		return nil
+	}
+
+	block, _ := rawList[0].(map[string]interface{})
+
+	return block
+}
@@ -0,0 +1,224 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+func TestMapStateToRepoConfigs(t *testing.T) {
+	t.Parallel()
+
+	repoName := "repo1"
+	repo2Name := "repo2"
+	visibility := "private"
+	trueString := "true"
+	falseString := "false"
+	approvalCount := "2"
+	defaultBranchName := "master"
+	pattern := "release/*"
+	sourceBranch := "develop"
+	squashTitle := "PR_TITLE"
+	templateSource := "an-owner/a-template"
+	pagesDomain := "repo1.example.com"
+	topics := []string{"go"}
+	contexts := []string{"ci/build"}
+	repository := &core.TerraformStateResource{
+		"github_repository",
+		"repo1",
+		map[string]interface{}{
+			"name":                      "repo1",
+			"node_id":                   "R_1",
+			"visibility":                "private",
+			"description":               "",
+			"archived":                  false,
+			"is_template":               true,
+			"has_issues":                true,
+			"allow_merge_commit":        false,
+			"allow_rebase_merge":        true,
+			"allow_update_branch":       true,
+			"merge_commit_title":        "MERGE_MESSAGE",
+			"squash_merge_commit_title": "PR_TITLE",
+			"topics":                    []interface{}{"go"},
+			"template": []interface{}{
+				map[string]interface{}{"owner": "an-owner", "repository": "a-template", "include_all_branches": true},
+			},
+			"pages": []interface{}{
+				map[string]interface{}{"cname": "repo1.example.com", "source": []interface{}{}},
+			},
+		},
+	}
+	newRepoConfig := func() *core.GhRepoConfig {
+		return &core.GhRepoConfig{
+			Name:       &repoName,
+			Visibility: &visibility,
+			Miscellaneous: &core.GhRepoMiscellaneousConfig{
+				Topics:     &topics,
+				IsTemplate: &trueString,
+				HasIssues:  &trueString,
+				Template:   &core.GhRepoTemplateConfig{Source: &templateSource, FullClone: &trueString},
+				Pages:      &core.GhRepoPagesConfig{Domain: &pagesDomain},
+			},
+			PullRequests: &core.GhRepoPullRequestConfig{
+				MergeStrategy: &core.GhRepoPRMergeStrategyConfig{AllowMerge: &falseString},
+				SquashCommit:  &core.GhRepoPRCommitConfig{Title: &squashTitle},
+				Branch:        &core.GhRepoPRBranchConfig{SuggestUpdate: &trueString},
+			},
+		}
+	}
+	repoWithProtection := newRepoConfig()
+	repoWithProtection.BranchProtections = &core.GhBranchProtectionsConfig{
+		{
+			Pattern:                      &pattern,
+			BaseGhBranchProtectionConfig: core.BaseGhBranchProtectionConfig{EnforceAdmins: &trueString},
+		},
+	}
+	cases := map[string]struct {
+		value    []*core.TerraformStateResource
+		expected []*core.GhRepoConfig
+		error    error
+	}{
+		"nil": {
+			nil,
+			[]*core.GhRepoConfig{},
+			nil,
+		},
+		"Repositories": {
+			[]*core.TerraformStateResource{
+				{"github_repository", "repo2", map[string]interface{}{"name": "repo2", "archived": false}},
+				repository,
+			},
+			[]*core.GhRepoConfig{
+				newRepoConfig(),
+				{Name: &repo2Name},
+			},
+			nil,
+		},
+		"Branches and protections": {
+			[]*core.TerraformStateResource{
+				{"github_repository", "repo2", map[string]interface{}{"name": "repo2"}},
+				{"github_branch_default", "repo2", map[string]interface{}{"repository": "repo2", "branch": "master"}},
+				{
+					"github_branch",
+					"repo2-develop",
+					map[string]interface{}{"repository": "repo2", "branch": "develop", "source_branch": "master", "source_sha": "abc"},
+				},
+				{
+					"github_branch",
+					"repo2-feature",
+					map[string]interface{}{"repository": "repo2", "branch": "feature", "source_branch": "develop"},
+				},
+				{
+					"github_branch_protection",
+					"repo2-release",
+					map[string]interface{}{
+						"repository_id":          "repo2",
+						"pattern":                "release/*",
+						"require_signed_commits": true,
+						"lock_branch":            true,
+					},
+				},
+				{
+					"github_branch_protection",
+					"repo2-develop",
+					map[string]interface{}{"repository_id": "repo2", "pattern": "develop", "allows_force_pushes": true},
+				},
+				{
+					"github_branch_protection",
+					"repo2-default",
+					map[string]interface{}{
+						"repository_id":  "repo2",
+						"pattern":        "master",
+						"enforce_admins": false,
+						"required_status_checks": []interface{}{
+							map[string]interface{}{"strict": false, "contexts": []interface{}{"ci/build"}},
+						},
+						"required_pull_request_reviews": []interface{}{
+							map[string]interface{}{
+								"dismiss_stale_reviews":           true,
+								"required_approving_review_count": float64(2),
+							},
+						},
+					},
+				},
+			},
+			[]*core.GhRepoConfig{
+				{
+					Name: &repo2Name,
+					DefaultBranch: &core.GhDefaultBranchConfig{
+						Name: &defaultBranchName,
+						BaseGhBranchConfig: core.BaseGhBranchConfig{
+							Protection: &core.BaseGhBranchProtectionConfig{
+								StatusChecks: &core.GhBranchProtectStatusChecksConfig{Required: &contexts},
+								PullRequestReviews: &core.GhBranchProtectPRReviewConfig{
+									ApprovalCount: &approvalCount,
+									Dismissals:    &core.GhBranchProtectPRReviewDismissalsConfig{Staled: &trueString},
+								},
+							},
+						},
+					},
+					Branches: &core.GhBranchesConfig{
+						"develop": &core.GhBranchConfig{
+							BaseGhBranchConfig: core.BaseGhBranchConfig{
+								Protection: &core.BaseGhBranchProtectionConfig{
+									Pushes: &core.GhBranchProtectPushesConfig{AllowsForcePushes: &trueString},
+								},
+							},
+						},
+						"feature": &core.GhBranchConfig{SourceBranch: &sourceBranch},
+					},
+					BranchProtections: &core.GhBranchProtectionsConfig{
+						{
+							Pattern: &pattern,
+							Forbid:  &trueString,
+							BaseGhBranchProtectionConfig: core.BaseGhBranchProtectionConfig{
+								RequireSignedCommits: &trueString,
+							},
+						},
+					},
+				},
+			},
+			nil,
+		},
+		"Protection linked by node ID": {
+			[]*core.TerraformStateResource{
+				repository,
+				{
+					"github_branch_protection",
+					"repo1-release",
+					map[string]interface{}{"repository_id": "R_1", "pattern": "release/*", "enforce_admins": true},
+				},
+			},
+			[]*core.GhRepoConfig{repoWithProtection},
+			nil,
+		},
+		"Unknown repository": {
+			[]*core.TerraformStateResource{
+				{"github_branch_default", "repo2", map[string]interface{}{"repository": "repo2", "branch": "master"}},
+				{"github_branch_protection", "repo2-default", map[string]interface{}{"pattern": "master"}},
+			},
+			nil,
+			core.ReverseMappingError([]error{
+				core.StateRepositoryNotFoundError("github_branch_default.repo2", &repo2Name),
+				core.StateRepositoryNotFoundError("github_branch_protection.repo2-default", nil),
+			}),
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				actual, err := core.MapStateToRepoConfigs(tc.value)
+
+				if tc.error != nil {
+					EnsureErrorMatching(t, tc.error, err)
+				} else {
+					EnsureConfigMatching(t, tc.expected, actual, nil, err)
+				}
+			},
+		)
+	}
+}
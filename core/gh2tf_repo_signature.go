@@ -0,0 +1,84 @@
+package core
+
+import (
+	"github.com/yoanm/go-gh2tf/ghrepository"
+	"github.com/yoanm/go-tfsig"
+)
+
+// RepositoryExtraRes contains `github_repository` resource attributes not managed by go-gh2tf.
+type RepositoryExtraRes struct {
+	ValueGenerator                      tfsig.ValueGenerator
+	IsTemplate                          *string
+	GitignoreTemplate                   *string
+	LicenseTemplate                     *string
+	AllowUpdateBranch                   *string
+	IgnoreVulnerabilityAlertsDuringRead *string
+	// PagesCname is appended to `pages` block, which is created if needed
+	PagesCname *string
+	// TemplateIncludeAllBranches is appended to `template` block only if it exists
+	TemplateIncludeAllBranches *string
+}
+
+/** Public **/
+
+// NewRepositorySignature returns the `github_repository` terraform resource as `tfsig.BlockSignature`, including
+// attributes not managed by go-gh2tf
+//
+// It returns `nil` if resource is empty.
+func NewRepositorySignature(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+) *tfsig.BlockSignature {
+	sig := ghrepository.NewSignature(MapToRepositoryRes(repoConfig, valGen, repoTfId))
+	if sig == nil {
+		return nil
+	}
+
+	appendRepositoryExtraContent(sig, MapToRepositoryExtraRes(repoConfig, valGen))
+
+	if securitySig := NewSecurityAndAnalysisSignature(MapToSecurityAndAnalysisRes(repoConfig, valGen)); securitySig != nil {
+		sig.AppendEmptyLine()
+		sig.AppendChild(securitySig)
+	}
+
+	return sig
+}
+
+/** Private **/
+
+func appendRepositoryExtraContent(sig *tfsig.BlockSignature, extraRes *RepositoryExtraRes) {
+	if extraRes == nil {
+		return
+	}
+
+	valGen := extraRes.ValueGenerator
+
+	if value := valGen.ToBool(extraRes.TemplateIncludeAllBranches); value != nil {
+		if templateSig := findChild(sig, "template"); templateSig != nil {
+			templateSig.AppendAttribute("include_all_branches", *value)
+		}
+	}
+
+	if value := valGen.ToString(extraRes.PagesCname); value != nil {
+		pagesSig := findChild(sig, "pages")
+		if pagesSig == nil {
+			pagesSig = tfsig.NewSignature("pages")
+			tfsig.AppendChildIfNotNil(sig, pagesSig)
+		}
+
+		insertAttributeBeforeChildren(pagesSig, "cname", *value)
+	}
+
+	appendAttributeGroup(sig, []attributeValue{
+		{"is_template", valGen.ToBool(extraRes.IsTemplate)},
+		{"gitignore_template", valGen.ToString(extraRes.GitignoreTemplate)},
+		{"license_template", valGen.ToString(extraRes.LicenseTemplate)},
+	})
+	appendAttributeGroup(sig, []attributeValue{
+		{"allow_update_branch", valGen.ToBool(extraRes.AllowUpdateBranch)},
+	})
+	appendAttributeGroup(sig, []attributeValue{
+		{"ignore_vulnerability_alerts_during_read", valGen.ToBool(extraRes.IgnoreVulnerabilityAlertsDuringRead)},
+	})
+}
@@ -0,0 +1,216 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+const (
+	RulesetTeamActorType              = "team"
+	RulesetIntegrationActorType       = "integration"
+	RulesetRepositoryRoleActorType    = "repository-role"
+	RulesetOrganizationAdminActorType = "organization-admin"
+	RulesetDeployKeyActorType         = "deploy-key"
+)
+
+/** Public **/
+
+// MapToRepositoryRulesetResList returns resources in the configuration order.
+func MapToRepositoryRulesetResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	ctx *WorkspaceContext,
+	links ...MapperLink,
+) []*RulesetRes {
+	if repoConfig == nil || repoConfig.Rulesets == nil {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := []*RulesetRes{}
+
+	for _, rulesetConfig := range *repoConfig.Rulesets {
+		if res := MapToRulesetRes(rulesetConfig, valGen, repoTfId, ctx); res != nil {
+			res.Repository = repoName
+			list = append(list, res)
+		}
+	}
+
+	return list
+}
+
+// MapToRulesetRes maps ruleset attributes shared by repository and organization rulesets, identifier is prefixed
+// by the provided one.
+//
+// Target defaults to "branch" and enforcement to "active".
+func MapToRulesetRes(
+	rulesetConfig *GhRulesetConfig,
+	valGen tfsig.ValueGenerator,
+	idPrefix string,
+	ctx *WorkspaceContext,
+) *RulesetRes {
+	if rulesetConfig == nil || rulesetConfig.Name == nil {
+		return nil
+	}
+
+	target, enforcement := rulesetConfig.Target, rulesetConfig.Enforcement
+	if target == nil {
+		tmp := "branch"
+		target = &tmp
+	}
+
+	if enforcement == nil {
+		tmp := "active"
+		enforcement = &tmp
+	}
+
+	return &RulesetRes{
+		ValueGenerator: valGen,
+		Identifier:     fmt.Sprintf("%s-%s", idPrefix, tfsig.ToTerraformIdentifier(*rulesetConfig.Name)),
+		Name:           rulesetConfig.Name,
+		Repository:     nil,
+		Target:         target,
+		Enforcement:    enforcement,
+		IncludeRefs:    rulesetConfig.Include,
+		ExcludeRefs:    rulesetConfig.Exclude,
+		BypassActors:   mapRulesetBypassActors(rulesetConfig, ctx),
+		Rules:          mapRulesetRules(rulesetConfig.Rules),
+	}
+}
+
+/** Private **/
+
+//nolint:gochecknoglobals // Compiled once
+var numericRegexp = regexp.MustCompile(`^[0-9]+$`)
+
+//nolint:gochecknoglobals // Easier to manage it as global var
+var (
+	rulesetActorTypes = map[string]string{
+		RulesetTeamActorType:              "Team",
+		RulesetIntegrationActorType:       "Integration",
+		RulesetRepositoryRoleActorType:    "RepositoryRole",
+		RulesetOrganizationAdminActorType: "OrganizationAdmin",
+		RulesetDeployKeyActorType:         "DeployKey",
+	}
+	// Default actor IDs, for actor types where it doesn't make sense.
+	rulesetDefaultActorIds = map[string]string{
+		RulesetOrganizationAdminActorType: "1",
+		RulesetDeployKeyActorType:         "0",
+	}
+	// Repository role IDs, by role name.
+	rulesetRepositoryRoleIds = map[string]string{
+		"maintain": "2",
+		"write":    "4",
+		"admin":    "5",
+	}
+)
+
+func mapRulesetBypassActors(rulesetConfig *GhRulesetConfig, ctx *WorkspaceContext) []*RulesetBypassActorRes {
+	if rulesetConfig.BypassActors == nil {
+		return nil
+	}
+
+	list := []*RulesetBypassActorRes{}
+
+	for _, actorConfig := range *rulesetConfig.BypassActors {
+		if actorConfig.Type == nil {
+			continue
+		}
+
+		actorId := mapRulesetBypassActorId(actorConfig, ctx)
+		if actorId == nil {
+			log.Warn().Msgf(
+				"Ruleset %s: unable to find a %s bypass actor ID => ignored",
+				*rulesetConfig.Name,
+				*actorConfig.Type,
+			)
+
+			continue
+		}
+
+		actorType := rulesetActorTypes[*actorConfig.Type]
+		bypassMode := "always"
+
+		if actorConfig.Mode != nil {
+			bypassMode = strings.ReplaceAll(*actorConfig.Mode, "-", "_")
+		}
+
+		list = append(list, &RulesetBypassActorRes{ActorId: actorId, ActorType: &actorType, BypassMode: &bypassMode})
+	}
+
+	return list
+}
+
+// mapRulesetBypassActorId returns nil if the ID can't be converted to a numeric ID.
+func mapRulesetBypassActorId(actorConfig *GhRulesetBypassActorConfig, ctx *WorkspaceContext) *string {
+	if actorConfig.Id == nil {
+		if defaultId, ok := rulesetDefaultActorIds[*actorConfig.Type]; ok {
+			return &defaultId
+		}
+
+		return nil
+	}
+
+	actorId := *actorConfig.Id
+
+	switch *actorConfig.Type {
+	case RulesetTeamActorType:
+		if !ctx.IsTeamIdOrDeclaredTeam(actorId) {
+			return nil
+		}
+
+		actorId = ctx.TeamId(actorId)
+	case RulesetRepositoryRoleActorType:
+		if roleId, ok := rulesetRepositoryRoleIds[actorId]; ok {
+			actorId = roleId
+		}
+	}
+
+	if actorId != *actorConfig.Id || numericRegexp.MatchString(actorId) {
+		return &actorId
+	}
+
+	return nil
+}
+
+func mapRulesetRules(rulesConfig *GhRulesetRulesConfig) *RulesetRulesRes {
+	if rulesConfig == nil {
+		return nil
+	}
+
+	res := &RulesetRulesRes{
+		Creation:              rulesConfig.RestrictCreation,
+		Update:                rulesConfig.RestrictUpdate,
+		Deletion:              rulesConfig.RestrictDeletion,
+		NonFastForward:        rulesConfig.RestrictForcePush,
+		RequiredLinearHistory: rulesConfig.RequireLinearHistory,
+		RequiredSignatures:    rulesConfig.RequireSignedCommits,
+		PullRequest:           nil,
+		RequiredStatusChecks:  nil,
+	}
+
+	if pr := rulesConfig.PullRequestReviews; pr != nil {
+		res.PullRequest = &RulesetPullRequestRes{
+			DismissStaleReviewsOnPush:      pr.DismissStaled,
+			RequireCodeOwnerReview:         pr.CodeownerApprovals,
+			RequireLastPushApproval:        pr.LastPushApproval,
+			RequiredApprovingReviewCount:   pr.ApprovalCount,
+			RequiredReviewThreadResolution: pr.ResolvedConversations,
+		}
+	}
+
+	if checks := rulesConfig.StatusChecks; checks != nil {
+		res.RequiredStatusChecks = &RulesetRequiredStatusChecksRes{
+			Contexts:                         checks.Required,
+			StrictRequiredStatusChecksPolicy: checks.Strict,
+		}
+	}
+
+	return res
+}
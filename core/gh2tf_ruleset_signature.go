@@ -0,0 +1,185 @@
+package core
+
+import (
+	"github.com/yoanm/go-tfsig"
+)
+
+// RulesetRes contains `github_repository_ruleset` resource attributes.
+type RulesetRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Name           *string
+	Repository     *string
+	Target         *string
+	Enforcement    *string
+	IncludeRefs    *[]string
+	ExcludeRefs    *[]string
+	BypassActors   []*RulesetBypassActorRes
+	Rules          *RulesetRulesRes
+}
+
+// RulesetBypassActorRes contains ruleset `bypass_actors` block attributes.
+type RulesetBypassActorRes struct {
+	ActorId    *string
+	ActorType  *string
+	BypassMode *string
+}
+
+// RulesetRulesRes contains ruleset `rules` block attributes.
+type RulesetRulesRes struct {
+	Creation              *string
+	Update                *string
+	Deletion              *string
+	NonFastForward        *string
+	RequiredLinearHistory *string
+	RequiredSignatures    *string
+	PullRequest           *RulesetPullRequestRes
+	RequiredStatusChecks  *RulesetRequiredStatusChecksRes
+}
+
+// RulesetPullRequestRes contains ruleset `pull_request` block attributes.
+type RulesetPullRequestRes struct {
+	DismissStaleReviewsOnPush      *string
+	RequireCodeOwnerReview         *string
+	RequireLastPushApproval        *string
+	RequiredApprovingReviewCount   *string
+	RequiredReviewThreadResolution *string
+}
+
+// RulesetRequiredStatusChecksRes contains ruleset `required_status_checks` block attributes.
+type RulesetRequiredStatusChecksRes struct {
+	Contexts                         *[]string
+	StrictRequiredStatusChecksPolicy *string
+}
+
+/** Public **/
+
+// NewRepositoryRulesetSignature returns the `github_repository_ruleset` terraform resource as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewRepositoryRulesetSignature(res *RulesetRes) *tfsig.BlockSignature {
+	if res == nil || res.Name == nil || res.Repository == nil {
+		return nil
+	}
+
+	valGen := res.ValueGenerator
+	sig := tfsig.NewResource("github_repository_ruleset", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "name", valGen.ToString(res.Name))
+	tfsig.AppendAttributeIfNotNil(sig, "repository", valGen.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "target", valGen.ToString(res.Target))
+	tfsig.AppendAttributeIfNotNil(sig, "enforcement", valGen.ToString(res.Enforcement))
+
+	if res.IncludeRefs != nil || res.ExcludeRefs != nil {
+		conditionsSig := tfsig.NewSignature("conditions")
+		conditionsSig.AppendChild(newRulesetRefNameSignature(res))
+
+		sig.AppendEmptyLine()
+		sig.AppendChild(conditionsSig)
+	}
+
+	appendRulesetCommonBlocks(sig, res)
+
+	return sig
+}
+
+/** Private **/
+
+// appendRulesetCommonBlocks appends blocks shared by repository and organization rulesets.
+func appendRulesetCommonBlocks(sig *tfsig.BlockSignature, res *RulesetRes) {
+	for _, actor := range res.BypassActors {
+		actorSig := tfsig.NewSignature("bypass_actors")
+		tfsig.AppendAttributeIfNotNil(actorSig, "actor_id", res.ValueGenerator.ToNumber(actor.ActorId))
+		tfsig.AppendAttributeIfNotNil(actorSig, "actor_type", res.ValueGenerator.ToString(actor.ActorType))
+		tfsig.AppendAttributeIfNotNil(actorSig, "bypass_mode", res.ValueGenerator.ToString(actor.BypassMode))
+
+		sig.AppendEmptyLine()
+		sig.AppendChild(actorSig)
+	}
+
+	// `rules` block is mandatory, even if empty
+	sig.AppendEmptyLine()
+	sig.AppendChild(newRulesetRulesSignature(res.ValueGenerator, res.Rules))
+}
+
+func newRulesetRefNameSignature(res *RulesetRes) *tfsig.BlockSignature {
+	// Both attributes are mandatory
+	include, exclude := res.IncludeRefs, res.ExcludeRefs
+	if include == nil {
+		include = &[]string{}
+	}
+
+	if exclude == nil {
+		exclude = &[]string{}
+	}
+
+	sig := tfsig.NewSignature("ref_name")
+	tfsig.AppendAttributeIfNotNil(sig, "include", res.ValueGenerator.ToStringList(include))
+	tfsig.AppendAttributeIfNotNil(sig, "exclude", res.ValueGenerator.ToStringList(exclude))
+
+	return sig
+}
+
+func newRulesetRulesSignature(valGen tfsig.ValueGenerator, rules *RulesetRulesRes) *tfsig.BlockSignature {
+	sig := tfsig.NewSignature("rules")
+	if rules == nil {
+		return sig
+	}
+
+	tfsig.AppendAttributeIfNotNil(sig, "creation", valGen.ToBool(rules.Creation))
+	tfsig.AppendAttributeIfNotNil(sig, "update", valGen.ToBool(rules.Update))
+	tfsig.AppendAttributeIfNotNil(sig, "deletion", valGen.ToBool(rules.Deletion))
+	tfsig.AppendAttributeIfNotNil(sig, "non_fast_forward", valGen.ToBool(rules.NonFastForward))
+	tfsig.AppendAttributeIfNotNil(sig, "required_linear_history", valGen.ToBool(rules.RequiredLinearHistory))
+	tfsig.AppendAttributeIfNotNil(sig, "required_signatures", valGen.ToBool(rules.RequiredSignatures))
+
+	if pr := rules.PullRequest; pr != nil {
+		prSig := tfsig.NewSignature("pull_request")
+		tfsig.AppendAttributeIfNotNil(prSig, "dismiss_stale_reviews_on_push", valGen.ToBool(pr.DismissStaleReviewsOnPush))
+		tfsig.AppendAttributeIfNotNil(prSig, "require_code_owner_review", valGen.ToBool(pr.RequireCodeOwnerReview))
+		tfsig.AppendAttributeIfNotNil(prSig, "require_last_push_approval", valGen.ToBool(pr.RequireLastPushApproval))
+		tfsig.AppendAttributeIfNotNil(
+			prSig,
+			"required_approving_review_count",
+			valGen.ToNumber(pr.RequiredApprovingReviewCount),
+		)
+		tfsig.AppendAttributeIfNotNil(
+			prSig,
+			"required_review_thread_resolution",
+			valGen.ToBool(pr.RequiredReviewThreadResolution),
+		)
+
+		appendChildAfterEmptyLineIfNeeded(sig, prSig)
+	}
+
+	if checks := rules.RequiredStatusChecks; checks != nil {
+		checksSig := tfsig.NewSignature("required_status_checks")
+
+		if checks.Contexts != nil {
+			for _, context := range *checks.Contexts {
+				checkSig := tfsig.NewSignature("required_check")
+				tfsig.AppendAttributeIfNotNil(checkSig, "context", valGen.ToString(&context))
+
+				checksSig.AppendChild(checkSig)
+			}
+		}
+
+		tfsig.AppendAttributeIfNotNil(
+			checksSig,
+			"strict_required_status_checks_policy",
+			valGen.ToBool(checks.StrictRequiredStatusChecksPolicy),
+		)
+
+		appendChildAfterEmptyLineIfNeeded(sig, checksSig)
+	}
+
+	return sig
+}
+
+func appendChildAfterEmptyLineIfNeeded(sig *tfsig.BlockSignature, child *tfsig.BlockSignature) {
+	if len(sig.GetElements()) > 0 {
+		sig.AppendEmptyLine()
+	}
+
+	sig.AppendChild(child)
+}
@@ -0,0 +1,110 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+/** Public **/
+
+// MapToSecurityAndAnalysisRes returns nil if there is no security and analysis configuration.
+func MapToSecurityAndAnalysisRes(repoConfig *GhRepoConfig, valGen tfsig.ValueGenerator) *SecurityAndAnalysisRes {
+	if repoConfig == nil || repoConfig.Security == nil {
+		return nil
+	}
+
+	return &SecurityAndAnalysisRes{
+		ValueGenerator:               valGen,
+		AdvancedSecurity:             mapSecurityAndAnalysisStatus(repoConfig.Security.AdvancedSecurity),
+		SecretScanning:               mapSecurityAndAnalysisStatus(repoConfig.Security.SecretScanning),
+		SecretScanningPushProtection: mapSecurityAndAnalysisStatus(repoConfig.Security.SecretScanningPushProtection),
+	}
+}
+
+func MapToDependabotSecurityUpdatesRes(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) *DependabotSecurityUpdatesRes {
+	if repoConfig == nil || repoConfig.Security == nil || repoConfig.Security.DependabotSecurityUpdates == nil {
+		return nil
+	}
+
+	return &DependabotSecurityUpdatesRes{
+		ValueGenerator: valGen,
+		Identifier:     repoTfId,
+		Repository:     mapRepositoryNameLink(repoConfig, repoTfId, links...),
+		Enabled:        repoConfig.Security.DependabotSecurityUpdates,
+	}
+}
+
+// MapToPrivateVulnerabilityReportingRes always links the resource to the repository, as the repository owner is
+// only known by the repository resource.
+func MapToPrivateVulnerabilityReportingRes(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+) *PrivateVulnerabilityReportingRes {
+	if repoConfig == nil || repoConfig.Security == nil || repoConfig.Security.PrivateVulnerabilityReporting == nil {
+		return nil
+	}
+
+	fullName := fmt.Sprintf("github_repository.%s.full_name", repoTfId)
+
+	return &PrivateVulnerabilityReportingRes{
+		ValueGenerator:     valGen,
+		Identifier:         repoTfId + "-private-vulnerability-reporting",
+		RepositoryFullName: &fullName,
+		Enabled:            repoConfig.Security.PrivateVulnerabilityReporting,
+	}
+}
+
+// MapToCodeScanningRes always links the resource to the repository, as the repository owner is only known by the
+// repository resource.
+func MapToCodeScanningRes(repoConfig *GhRepoConfig, valGen tfsig.ValueGenerator, repoTfId string) *CodeScanningRes {
+	if repoConfig == nil || repoConfig.Security == nil || repoConfig.Security.CodeScanning == nil {
+		return nil
+	}
+
+	config := repoConfig.Security.CodeScanning
+	fullName := fmt.Sprintf("github_repository.%s.full_name", repoTfId)
+	res := &CodeScanningRes{
+		ValueGenerator:     valGen,
+		Identifier:         repoTfId + "-code-scanning",
+		RepositoryFullName: &fullName,
+		State:              config.State,
+		QuerySuite:         config.QuerySuite,
+		Languages:          nil,
+	}
+
+	if config.Languages != nil {
+		res.Languages = []string{}
+
+		for _, language := range *config.Languages {
+			// Templates may append an already existing language
+			if !slices.Contains(res.Languages, language) {
+				res.Languages = append(res.Languages, language)
+			}
+		}
+	}
+
+	return res
+}
+
+/** Private **/
+
+func mapSecurityAndAnalysisStatus(enabled *string) *string {
+	if enabled == nil {
+		return nil
+	}
+
+	status := "disabled"
+	if *enabled == "true" {
+		status = "enabled"
+	}
+
+	return &status
+}
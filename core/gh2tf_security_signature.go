@@ -0,0 +1,213 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/yoanm/go-tfsig"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// SecurityAndAnalysisRes contains `github_repository->security_and_analysis` block attributes.
+type SecurityAndAnalysisRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	// Statuses are either "enabled" or "disabled"
+	AdvancedSecurity             *string
+	SecretScanning               *string
+	SecretScanningPushProtection *string
+}
+
+// DependabotSecurityUpdatesRes contains `github_repository_dependabot_security_updates` resource attributes.
+type DependabotSecurityUpdatesRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Repository     *string
+	Enabled        *string
+}
+
+// PrivateVulnerabilityReportingRes contains attributes of the `terraform_data` resource managing private
+// vulnerability reporting.
+type PrivateVulnerabilityReportingRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	// RepositoryFullName is a terraform expression returning the "owner/name" repository name
+	RepositoryFullName *string
+	Enabled            *string
+}
+
+// CodeScanningRes contains attributes of the `terraform_data` resource managing code scanning default setup.
+type CodeScanningRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	// RepositoryFullName is a terraform expression returning the "owner/name" repository name
+	RepositoryFullName *string
+	State              *string
+	QuerySuite         *string
+	Languages          []string
+}
+
+/** Public **/
+
+// NewSecurityAndAnalysisSignature returns the `github_repository->security_and_analysis` terraform block
+// as `tfsig.BlockSignature`
+//
+// It returns `nil` if block is empty.
+func NewSecurityAndAnalysisSignature(res *SecurityAndAnalysisRes) *tfsig.BlockSignature {
+	if res == nil ||
+		(res.AdvancedSecurity == nil && res.SecretScanning == nil && res.SecretScanningPushProtection == nil) {
+		return nil
+	}
+
+	sig := tfsig.NewSignature("security_and_analysis")
+
+	appendSecurityAndAnalysisStatusBlock(sig, "advanced_security", res.AdvancedSecurity, res.ValueGenerator)
+	appendSecurityAndAnalysisStatusBlock(sig, "secret_scanning", res.SecretScanning, res.ValueGenerator)
+	appendSecurityAndAnalysisStatusBlock(
+		sig,
+		"secret_scanning_push_protection",
+		res.SecretScanningPushProtection,
+		res.ValueGenerator,
+	)
+
+	return sig
+}
+
+// NewDependabotSecurityUpdatesSignature returns the `github_repository_dependabot_security_updates` terraform
+// resource as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewDependabotSecurityUpdatesSignature(res *DependabotSecurityUpdatesRes) *tfsig.BlockSignature {
+	if res == nil || res.Repository == nil || res.Enabled == nil {
+		return nil
+	}
+
+	sig := tfsig.NewResource("github_repository_dependabot_security_updates", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", res.ValueGenerator.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "enabled", res.ValueGenerator.ToBool(res.Enabled))
+
+	return sig
+}
+
+// NewPrivateVulnerabilityReportingSignature returns the `terraform_data` terraform resource managing private
+// vulnerability reporting with GitHub CLI, as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewPrivateVulnerabilityReportingSignature(res *PrivateVulnerabilityReportingRes) *tfsig.BlockSignature {
+	if res == nil || res.RepositoryFullName == nil || res.Enabled == nil {
+		return nil
+	}
+
+	enabled := res.ValueGenerator.ToBool(res.Enabled)
+	method := "DELETE"
+
+	if enabled.Type() == cty.Bool && enabled.True() {
+		method = "PUT"
+	}
+
+	sig := tfsig.NewResource("terraform_data", res.Identifier)
+	// Command must run again if the repository or the expected status change
+	sig.AppendAttribute(
+		"triggers_replace",
+		cty.TupleVal([]cty.Value{*res.ValueGenerator.ToIdent(res.RepositoryFullName), *enabled}),
+	)
+
+	sig.AppendEmptyLine()
+	sig.AppendChild(newGhApiProvisionerSignature(
+		res.ValueGenerator,
+		fmt.Sprintf("gh api --method %s repos/${%s}/private-vulnerability-reporting", method, *res.RepositoryFullName),
+		false,
+	))
+	// Reporting is disabled by default
+	sig.AppendEmptyLine()
+	sig.AppendChild(newGhApiProvisionerSignature(
+		res.ValueGenerator,
+		"gh api --method DELETE repos/${self.triggers_replace[0]}/private-vulnerability-reporting",
+		true,
+	))
+
+	return sig
+}
+
+// NewCodeScanningSignature returns the `terraform_data` terraform resource managing code scanning default setup with
+// GitHub CLI, as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewCodeScanningSignature(res *CodeScanningRes) *tfsig.BlockSignature {
+	if res == nil || res.RepositoryFullName == nil ||
+		(res.State == nil && res.QuerySuite == nil && len(res.Languages) == 0) {
+		return nil
+	}
+
+	fields := []string{}
+	if res.State != nil {
+		fields = append(fields, "state="+*res.State)
+	}
+
+	if res.QuerySuite != nil {
+		fields = append(fields, "query_suite="+*res.QuerySuite)
+	}
+
+	for _, language := range res.Languages {
+		fields = append(fields, "languages[]="+language)
+	}
+
+	// Command must run again if the repository or the expected setup change
+	triggers := []cty.Value{*res.ValueGenerator.ToIdent(res.RepositoryFullName)}
+	command := fmt.Sprintf("gh api --method PATCH repos/${%s}/code-scanning/default-setup", *res.RepositoryFullName)
+
+	for _, field := range fields {
+		triggers = append(triggers, cty.StringVal(field))
+		command += fmt.Sprintf(" -f '%s'", field)
+	}
+
+	sig := tfsig.NewResource("terraform_data", res.Identifier)
+	sig.AppendAttribute("triggers_replace", cty.TupleVal(triggers))
+
+	sig.AppendEmptyLine()
+	sig.AppendChild(newGhApiProvisionerSignature(res.ValueGenerator, command, false))
+	// Default setup is not configured by default
+	sig.AppendEmptyLine()
+	sig.AppendChild(newGhApiProvisionerSignature(
+		res.ValueGenerator,
+		"gh api --method PATCH repos/${self.triggers_replace[0]}/code-scanning/default-setup -f 'state=not-configured'",
+		true,
+	))
+
+	return sig
+}
+
+/** Private **/
+
+func appendSecurityAndAnalysisStatusBlock(
+	sig *tfsig.BlockSignature,
+	name string,
+	status *string,
+	valGen tfsig.ValueGenerator,
+) {
+	if status == nil {
+		return
+	}
+
+	statusSig := tfsig.NewSignature(name)
+	tfsig.AppendAttributeIfNotNil(statusSig, "status", valGen.ToString(status))
+
+	sig.AppendChild(statusSig)
+}
+
+// newGhApiProvisionerSignature returns the `local-exec` provisioner running the GitHub CLI command, either on
+// resource creation or on resource destruction
+//
+// Destroy-time provisioner command can only refer to the resource itself (e.g. `self.triggers_replace`).
+func newGhApiProvisionerSignature(valGen tfsig.ValueGenerator, command string, onDestroy bool) *tfsig.BlockSignature {
+	sig := tfsig.NewSignature("provisioner", "local-exec")
+
+	if onDestroy {
+		when := "destroy"
+		tfsig.AppendAttributeIfNotNil(sig, "when", valGen.ToIdent(&when))
+	}
+
+	command = "\"" + command + "\""
+	tfsig.AppendAttributeIfNotNil(sig, "command", valGen.ToIdent(&command))
+
+	return sig
+}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"github.com/yoanm/go-tfsig"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type attributeValue struct {
+	name  string
+	value *cty.Value
+}
+
+// appendAttributeGroup appends not nil attributes, preceded by an empty line if at least one is appended.
+func appendAttributeGroup(sig *tfsig.BlockSignature, attributeList []attributeValue) {
+	hasAttribute := false
+
+	for _, attr := range attributeList {
+		if attr.value == nil {
+			continue
+		}
+
+		if !hasAttribute {
+			hasAttribute = true
+
+			sig.AppendEmptyLine()
+		}
+
+		sig.AppendAttribute(attr.name, *attr.value)
+	}
+}
+
+// toNumberList converts a list of numeric (or 'ident' token) strings to `cty.Value` list, nil if list is nil or empty.
+func toNumberList(valGen tfsig.ValueGenerator, list *[]string) *cty.Value {
+	if list == nil || len(*list) == 0 {
+		return nil
+	}
+
+	newList := make([]cty.Value, len(*list))
+
+	for i := range *list {
+		rawValue := (*list)[i]
+		newList[i] = *valGen.ToNumber(&rawValue)
+	}
+
+	val := cty.TupleVal(newList)
+
+	return &val
+}
+
+// insertAttributeBeforeChildren inserts the attribute after existing attributes, so before the first empty line or
+// child block if any.
+func insertAttributeBeforeChildren(sig *tfsig.BlockSignature, name string, value cty.Value) {
+	elements := sig.GetElements()
+	position := len(elements)
+
+	for idx, element := range elements {
+		if !element.IsBodyAttribute() {
+			position = idx
+
+			break
+		}
+	}
+
+	newElements := make(tfsig.BodyElements, 0, len(elements)+1)
+	newElements = append(newElements, elements[:position]...)
+	newElements = append(newElements, tfsig.NewBodyAttribute(name, value))
+	newElements = append(newElements, elements[position:]...)
+
+	sig.SetElements(newElements)
+}
+
+// findChild returns the first child block with the provided type, nil if none.
+func findChild(sig *tfsig.BlockSignature, blockType string) *tfsig.BlockSignature {
+	for _, element := range sig.GetElements() {
+		if element.IsBodyBlock() && element.GetBodyBlock().GetType() == blockType {
+			return element.GetBodyBlock()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+const (
+	TeamMaintainerRole = "maintainer"
+	TeamMemberRole     = "member"
+)
+
+// WorkspaceContext contains workspace wide information, used to link resources defined in different files.
+type WorkspaceContext struct {
+	// Team terraform identifiers, by team name
+	TeamTfIds map[string]string
+	// Custom property value types, by property name
+	CustomPropertyTypes map[string]string
+}
+
+/** Public **/
+
+// NewWorkspaceContext returns the context related to the provided (computed) config.
+func NewWorkspaceContext(config *Config) *WorkspaceContext {
+	ctx := &WorkspaceContext{TeamTfIds: map[string]string{}, CustomPropertyTypes: map[string]string{}}
+
+	if config != nil {
+		for _, team := range config.Teams {
+			if team.Name != nil {
+				ctx.TeamTfIds[*team.Name] = tfsig.ToTerraformIdentifier(*team.Name)
+			}
+		}
+
+		for _, property := range config.CustomProperties {
+			if property.Name != nil && property.ValueType != nil {
+				ctx.CustomPropertyTypes[*property.Name] = *property.ValueType
+			}
+		}
+	}
+
+	return ctx
+}
+
+// TeamId returns a reference to the `github_team` resource id if the team is declared, else the provided value
+// (expected to be a team ID or slug).
+func (ctx *WorkspaceContext) TeamId(team string) string {
+	if ctx != nil {
+		if teamTfId, ok := ctx.TeamTfIds[team]; ok {
+			return fmt.Sprintf("github_team.%s.id", teamTfId)
+		}
+	}
+
+	return team
+}
+
+// BranchProtectionBypasser returns the `pull_request_bypassers` value for the provided bypasser:
+//   - '@team' is replaced by a reference to the `github_team` resource node ID if the team is declared
+//   - 'user' is prefixed by '/', as expected by GitHub for users
+//   - '/user' and 'org/team' are kept as is
+func (ctx *WorkspaceContext) BranchProtectionBypasser(bypasser string) string {
+	if team, isTeam := strings.CutPrefix(bypasser, "@"); isTeam {
+		if ctx != nil {
+			if teamTfId, ok := ctx.TeamTfIds[team]; ok {
+				return fmt.Sprintf("github_team.%s.node_id", teamTfId)
+			}
+		}
+
+		return bypasser
+	}
+
+	if !strings.Contains(bypasser, "/") {
+		return "/" + bypasser
+	}
+
+	return bypasser
+}
+
+// IsTeamIdOrDeclaredTeam returns true if the provided value is either a team ID or a declared team name.
+func (ctx *WorkspaceContext) IsTeamIdOrDeclaredTeam(team string) bool {
+	return numericRegexp.MatchString(team) || ctx.TeamId(team) != team
+}
+
+// TeamSlug returns the slug GitHub generates for the provided team name.
+func TeamSlug(name string) string {
+	return strings.Trim(teamSlugInvalidCharsRegexp.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+func MapToTeamRes(
+	teamConfig *GhTeamConfig,
+	valGen tfsig.ValueGenerator,
+	teamTfId string,
+	ctx *WorkspaceContext,
+) *TeamRes {
+	if teamConfig == nil {
+		return nil
+	}
+
+	var parentTeamId *string
+
+	if teamConfig.Parent != nil {
+		tmp := ctx.TeamId(*teamConfig.Parent)
+		parentTeamId = &tmp
+	}
+
+	return &TeamRes{
+		ValueGenerator: valGen,
+		Identifier:     teamTfId,
+		Name:           teamConfig.Name,
+		Description:    teamConfig.Description,
+		Privacy:        teamConfig.Privacy,
+		ParentTeamId:   parentTeamId,
+	}
+}
+
+// MapToTeamMembersRes returns nil if neither maintainers nor members are configured.
+//
+// A user listed as both maintainer and member is managed as maintainer.
+func MapToTeamMembersRes(teamConfig *GhTeamConfig, valGen tfsig.ValueGenerator, teamTfId string) *TeamMembersRes {
+	if teamConfig == nil || (teamConfig.Maintainers == nil && teamConfig.Members == nil) {
+		return nil
+	}
+
+	teamId := fmt.Sprintf("github_team.%s.id", teamTfId)
+	memberList := []*TeamMemberRes{}
+	seen := map[string]bool{}
+	appendMembers := func(usernameList *[]string, role string) {
+		if usernameList == nil {
+			return
+		}
+
+		for _, username := range *usernameList {
+			if !seen[username] {
+				seen[username] = true
+				memberList = append(memberList, &TeamMemberRes{Username: &username, Role: &role})
+			}
+		}
+	}
+
+	appendMembers(teamConfig.Maintainers, TeamMaintainerRole)
+	appendMembers(teamConfig.Members, TeamMemberRole)
+
+	return &TeamMembersRes{
+		ValueGenerator: valGen,
+		Identifier:     teamTfId,
+		TeamId:         &teamId,
+		Members:        memberList,
+	}
+}
+
+// MapToTeamRepositoryResList returns resources sorted by team name.
+func MapToTeamRepositoryResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	ctx *WorkspaceContext,
+	links ...MapperLink,
+) []*TeamRepositoryRes {
+	if repoConfig == nil || repoConfig.Teams == nil {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := []*TeamRepositoryRes{}
+	keys, permissions := MapToSortedListWithKeys(*repoConfig.Teams)
+
+	for idx, team := range keys {
+		teamId := ctx.TeamId(team)
+		permission := permissions[idx]
+
+		list = append(list, &TeamRepositoryRes{
+			ValueGenerator: valGen,
+			Identifier:     fmt.Sprintf("%s-%s", repoTfId, tfsig.ToTerraformIdentifier(team)),
+			TeamId:         &teamId,
+			Repository:     repoName,
+			Permission:     &permission,
+		})
+	}
+
+	return list
+}
+
+// MapToRepositoryCollaboratorResList returns resources sorted by username.
+func MapToRepositoryCollaboratorResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) []*RepositoryCollaboratorRes {
+	if repoConfig == nil || repoConfig.Collaborators == nil {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := []*RepositoryCollaboratorRes{}
+	keys, permissions := MapToSortedListWithKeys(*repoConfig.Collaborators)
+
+	for idx, username := range keys {
+		permission := permissions[idx]
+
+		list = append(list, &RepositoryCollaboratorRes{
+			ValueGenerator: valGen,
+			Identifier:     fmt.Sprintf("%s-%s", repoTfId, tfsig.ToTerraformIdentifier(username)),
+			Repository:     repoName,
+			Username:       &username,
+			Permission:     &permission,
+		})
+	}
+
+	return list
+}
+
+/** Private **/
+
+//nolint:gochecknoglobals // Compiled once
+var teamSlugInvalidCharsRegexp = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+func mapRepositoryNameLink(repoConfig *GhRepoConfig, repoTfId string, links ...MapperLink) *string {
+	for _, link := range links {
+		if link == LinkToRepository {
+			// /!\ resource can't be configured if repository doesn't exist
+			// => Add an explicit dependency by using "github_repository.${repoTfId}.name"
+			tmp := fmt.Sprintf("github_repository.%s.name", repoTfId)
+
+			return &tmp
+		}
+	}
+
+	return repoConfig.Name
+}
@@ -0,0 +1,127 @@
+package core
+
+import (
+	"github.com/yoanm/go-tfsig"
+)
+
+// TeamRes contains `github_team` resource attributes.
+type TeamRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Name           *string
+	Description    *string
+	Privacy        *string
+	ParentTeamId   *string
+}
+
+// TeamMembersRes contains `github_team_members` resource attributes.
+type TeamMembersRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	TeamId         *string
+	Members        []*TeamMemberRes
+}
+
+// TeamMemberRes contains `github_team_members` `members` block attributes.
+type TeamMemberRes struct {
+	Username *string
+	Role     *string
+}
+
+// TeamRepositoryRes contains `github_team_repository` resource attributes.
+type TeamRepositoryRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	TeamId         *string
+	Repository     *string
+	Permission     *string
+}
+
+// RepositoryCollaboratorRes contains `github_repository_collaborator` resource attributes.
+type RepositoryCollaboratorRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Repository     *string
+	Username       *string
+	Permission     *string
+}
+
+/** Public **/
+
+// NewTeamSignature returns the `github_team` terraform resource as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewTeamSignature(res *TeamRes) *tfsig.BlockSignature {
+	if res == nil || res.Name == nil {
+		return nil
+	}
+
+	sig := tfsig.NewResource("github_team", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "name", res.ValueGenerator.ToString(res.Name))
+	tfsig.AppendAttributeIfNotNil(sig, "description", res.ValueGenerator.ToString(res.Description))
+	tfsig.AppendAttributeIfNotNil(sig, "privacy", res.ValueGenerator.ToString(res.Privacy))
+	tfsig.AppendAttributeIfNotNil(sig, "parent_team_id", res.ValueGenerator.ToString(res.ParentTeamId))
+
+	return sig
+}
+
+// NewTeamMembersSignature returns the `github_team_members` terraform resource as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewTeamMembersSignature(res *TeamMembersRes) *tfsig.BlockSignature {
+	if res == nil || res.TeamId == nil || len(res.Members) == 0 {
+		return nil
+	}
+
+	sig := tfsig.NewResource("github_team_members", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "team_id", res.ValueGenerator.ToString(res.TeamId))
+
+	for _, member := range res.Members {
+		sig.AppendEmptyLine()
+
+		memberSig := tfsig.NewSignature("members")
+		tfsig.AppendAttributeIfNotNil(memberSig, "username", res.ValueGenerator.ToString(member.Username))
+		tfsig.AppendAttributeIfNotNil(memberSig, "role", res.ValueGenerator.ToString(member.Role))
+
+		sig.AppendChild(memberSig)
+	}
+
+	return sig
+}
+
+// NewTeamRepositorySignature returns the `github_team_repository` terraform resource as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewTeamRepositorySignature(res *TeamRepositoryRes) *tfsig.BlockSignature {
+	if res == nil || res.TeamId == nil || res.Repository == nil {
+		return nil
+	}
+
+	sig := tfsig.NewResource("github_team_repository", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "team_id", res.ValueGenerator.ToString(res.TeamId))
+	tfsig.AppendAttributeIfNotNil(sig, "repository", res.ValueGenerator.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "permission", res.ValueGenerator.ToString(res.Permission))
+
+	return sig
+}
+
+// NewRepositoryCollaboratorSignature returns the `github_repository_collaborator` terraform resource
+// as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewRepositoryCollaboratorSignature(res *RepositoryCollaboratorRes) *tfsig.BlockSignature {
+	if res == nil || res.Username == nil || res.Repository == nil {
+		return nil
+	}
+
+	sig := tfsig.NewResource("github_repository_collaborator", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", res.ValueGenerator.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "username", res.ValueGenerator.ToString(res.Username))
+	tfsig.AppendAttributeIfNotNil(sig, "permission", res.ValueGenerator.ToString(res.Permission))
+
+	return sig
+}
@@ -0,0 +1,100 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+// webhookUrlHashLength is the number of URL hash bytes used by webhook identifiers.
+const webhookUrlHashLength = 6
+
+/** Public **/
+
+// MapToWebhookResList returns resources in the configuration order.
+//
+// Events default to "push", as GitHub does.
+func MapToWebhookResList(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	links ...MapperLink,
+) []*WebhookRes {
+	if repoConfig == nil || repoConfig.Webhooks == nil {
+		return nil
+	}
+
+	repoName := mapRepositoryNameLink(repoConfig, repoTfId, links...)
+	list := []*WebhookRes{}
+
+	for _, webhookConfig := range *repoConfig.Webhooks {
+		if webhookConfig == nil || webhookConfig.Url == nil {
+			continue
+		}
+
+		events := &[]string{"push"}
+		if webhookConfig.Events != nil {
+			events = &[]string{}
+			for _, event := range *webhookConfig.Events {
+				// Templates may append an already existing event
+				if !slices.Contains(*events, event) {
+					*events = append(*events, event)
+				}
+			}
+		}
+
+		var secret *string
+		if webhookConfig.Secret != nil {
+			tmp := "var." + *webhookConfig.Secret
+			secret = &tmp
+		}
+
+		list = append(list, &WebhookRes{
+			ValueGenerator: valGen,
+			Identifier:     mapWebhookTfId(repoTfId, webhookConfig),
+			Repository:     repoName,
+			Active:         webhookConfig.Active,
+			Events:         events,
+			Url:            webhookConfig.Url,
+			ContentType:    webhookConfig.ContentType,
+			InsecureSsl:    webhookConfig.InsecureSsl,
+			Secret:         secret,
+		})
+	}
+
+	return list
+}
+
+// MapToWebhookSecretInputVariableList returns the name of terraform input variables expected to provide webhook
+// secrets.
+func MapToWebhookSecretInputVariableList(repoConfig *GhRepoConfig) []string {
+	if repoConfig == nil || repoConfig.Webhooks == nil {
+		return nil
+	}
+
+	list := []string{}
+
+	for _, webhookConfig := range *repoConfig.Webhooks {
+		if webhookConfig != nil && webhookConfig.Url != nil && webhookConfig.Secret != nil {
+			list = append(list, *webhookConfig.Secret)
+		}
+	}
+
+	return list
+}
+
+/** Private **/
+
+// mapWebhookTfId uses the webhook name if provided, a hash of the URL otherwise (URL may contain credentials).
+func mapWebhookTfId(repoTfId string, webhookConfig *GhWebhookConfig) string {
+	if webhookConfig.Name != nil {
+		return fmt.Sprintf("%s-%s", repoTfId, tfsig.ToTerraformIdentifier(*webhookConfig.Name))
+	}
+
+	hash := sha256.Sum256([]byte(*webhookConfig.Url))
+
+	return fmt.Sprintf("%s-webhook-%s", repoTfId, hex.EncodeToString(hash[:webhookUrlHashLength]))
+}
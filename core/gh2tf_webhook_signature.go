@@ -0,0 +1,47 @@
+package core
+
+import (
+	"github.com/yoanm/go-tfsig"
+)
+
+// WebhookRes contains `github_repository_webhook` resource attributes.
+type WebhookRes struct {
+	ValueGenerator tfsig.ValueGenerator
+	Identifier     string
+	Repository     *string
+	Active         *string
+	Events         *[]string
+	Url            *string
+	ContentType    *string
+	InsecureSsl    *string
+	Secret         *string
+}
+
+/** Public **/
+
+// NewWebhookSignature returns the `github_repository_webhook` terraform resource as `tfsig.BlockSignature`
+//
+// It returns `nil` if resource is empty.
+func NewWebhookSignature(res *WebhookRes) *tfsig.BlockSignature {
+	if res == nil || res.Repository == nil || res.Url == nil {
+		return nil
+	}
+
+	valGen := res.ValueGenerator
+	sig := tfsig.NewResource("github_repository_webhook", res.Identifier)
+
+	tfsig.AppendAttributeIfNotNil(sig, "repository", valGen.ToString(res.Repository))
+	tfsig.AppendAttributeIfNotNil(sig, "active", valGen.ToBool(res.Active))
+	tfsig.AppendAttributeIfNotNil(sig, "events", valGen.ToStringList(res.Events))
+
+	configSig := tfsig.NewSignature("configuration")
+	tfsig.AppendAttributeIfNotNil(configSig, "url", valGen.ToString(res.Url))
+	tfsig.AppendAttributeIfNotNil(configSig, "content_type", valGen.ToString(res.ContentType))
+	tfsig.AppendAttributeIfNotNil(configSig, "insecure_ssl", valGen.ToBool(res.InsecureSsl))
+	tfsig.AppendAttributeIfNotNil(configSig, "secret", valGen.ToIdent(res.Secret))
+
+	sig.AppendEmptyLine()
+	sig.AppendChild(configSig)
+
+	return sig
+}
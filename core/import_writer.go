@@ -0,0 +1,44 @@
+package core
+
+import (
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/rs/zerolog/log"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+/** Public **/
+
+// NewHclImports returns a file containing an "import" block (Terraform 1.5+) for each provided resource.
+//
+// Resources without import ID are ignored.
+func NewHclImports(resources []*TerraformResource, valGen tfsig.ValueGenerator) *hclwrite.File {
+	hclFile := hclwrite.NewEmptyFile()
+
+	for _, res := range resources {
+		if res.ImportId == "" {
+			log.Warn().Msgf("Unable to compute import ID for %s => ignored", res.Address)
+
+			continue
+		}
+
+		if len(hclFile.Body().Blocks()) > 0 {
+			hclFile.Body().AppendNewline()
+		}
+
+		hclFile.Body().AppendBlock(newImportSignature(res, valGen).Build())
+	}
+
+	return hclFile
+}
+
+/** Private **/
+
+func newImportSignature(res *TerraformResource, valGen tfsig.ValueGenerator) *tfsig.BlockSignature {
+	sig := tfsig.NewSignature("import")
+
+	tfsig.AppendAttributeIfNotNil(sig, "to", valGen.ToIdent(&res.Address))
+	tfsig.AppendAttributeIfNotNil(sig, "id", valGen.ToString(&res.ImportId))
+
+	return sig
+}
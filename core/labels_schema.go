@@ -0,0 +1,89 @@
+package core
+
+import "strings"
+
+type GhLabelsConfig struct {
+	//nolint:tagliatelle // yaml templates, not config templates => better to use underscore here
+	ConfigTemplates *[]string `yaml:"_templates,omitempty,flow"`
+	// Authoritative means labels not managed by the configuration will be removed from the repository
+	Authoritative *string              `yaml:"authoritative,omitempty"`
+	Items         *GhLabelsItemsConfig `yaml:"items,omitempty"`
+}
+
+func (to *GhLabelsConfig) Merge(from *GhLabelsConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeSliceIfNotNil(&to.ConfigTemplates, from.ConfigTemplates)
+	mergeStringIfNotNil(&to.Authoritative, from.Authoritative)
+
+	if from.Items != nil {
+		if to.Items == nil {
+			to.Items = &GhLabelsItemsConfig{}
+		}
+
+		to.Items.Merge(from.Items)
+	}
+}
+
+// GhLabelsItemsConfig contains labels in declaration order.
+type GhLabelsItemsConfig []*GhLabelConfig
+
+// Merge merges labels sharing the same name, and appends the other ones.
+//
+// Only labels existing before the merge are looked up, so duplicated names in from are kept and can be reported.
+func (to *GhLabelsItemsConfig) Merge(from *GhLabelsItemsConfig) {
+	if from == nil {
+		return
+	}
+
+	existingList := *to
+
+	for _, labelConfig := range *from {
+		if labelConfig == nil {
+			continue
+		}
+
+		if existingVal := existingList.Get(labelConfig.Name); existingVal != nil {
+			existingVal.Merge(labelConfig)
+		} else {
+			//nolint:exhaustruct // No need here, it's base structure
+			newVal := &GhLabelConfig{}
+			newVal.Merge(labelConfig)
+			*to = append(*to, newVal)
+		}
+	}
+}
+
+// Get returns the label with the same name, or nil if there is none. As for GitHub, names are case-insensitive.
+func (to *GhLabelsItemsConfig) Get(name *string) *GhLabelConfig {
+	if name == nil {
+		return nil
+	}
+
+	for _, labelConfig := range *to {
+		if labelConfig != nil && labelConfig.Name != nil && strings.EqualFold(*labelConfig.Name, *name) {
+			return labelConfig
+		}
+	}
+
+	return nil
+}
+
+type GhLabelConfig struct {
+	Name *string `yaml:"name,omitempty"`
+	// Color is an hexadecimal color code, without the leading #
+	Color       *string `yaml:"color,omitempty"`
+	Description *string `yaml:"description,omitempty"`
+}
+
+func (to *GhLabelConfig) Merge(from *GhLabelConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeStringIfNotNil(&to.Name, from.Name)
+	mergeStringIfNotNil(&to.Color, from.Color)
+	mergeStringIfNotNil(&to.Description, from.Description)
+}
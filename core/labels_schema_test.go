@@ -0,0 +1,144 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+func updateGhLabelsConfigHelper(c *core.GhLabelsConfig, stringToCopy *string, newSliceToCopy *[]string, updatePtr bool) {
+	updateSlicePtrHelper(&c.ConfigTemplates, newSliceToCopy, updatePtr)
+	updateStringPtrHelper(&c.Authoritative, stringToCopy, updatePtr)
+
+	if c.Items == nil || updatePtr {
+		c.Items = &core.GhLabelsItemsConfig{}
+	}
+
+	if len(*c.Items) == 0 {
+		name := "a-label"
+		*c.Items = append(*c.Items, &core.GhLabelConfig{Name: &name})
+	}
+
+	updateStringPtrHelper(&(*c.Items)[0].Color, stringToCopy, updatePtr)
+	updateStringPtrHelper(&(*c.Items)[0].Description, stringToCopy, updatePtr)
+}
+
+func TestGhLabelsConfig_Merge(t *testing.T) {
+	t.Parallel()
+	// Ensure updating from afterward doesn't affect result of merge and vice versa
+	ensureMergeWithoutOverflowBetweenToAndFrom(
+		t,
+		core.GhLabelsConfig{},
+		func(to, from *core.GhLabelsConfig) {
+			to.Merge(from)
+		},
+		updateGhLabelsConfigHelper,
+	)
+}
+
+func TestGhLabelsItemsConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	bug := "bug"
+	upperBug := "Bug"
+	feature := "feature"
+	documentation := "documentation"
+	red := "ff0000"
+	green := "00ff00"
+	description := "a description"
+	cases := map[string]struct {
+		value    *core.GhLabelsItemsConfig
+		from     *core.GhLabelsItemsConfig
+		expected *core.GhLabelsItemsConfig
+	}{
+		"full": {
+			&core.GhLabelsItemsConfig{{&bug, &red, nil}, {&feature, &green, nil}},
+			&core.GhLabelsItemsConfig{{&bug, nil, &description}, {&documentation, &green, nil}},
+			&core.GhLabelsItemsConfig{{&bug, &red, &description}, {&feature, &green, nil}, {&documentation, &green, nil}},
+		},
+		"case insensitive names": {
+			&core.GhLabelsItemsConfig{{&bug, &red, nil}},
+			&core.GhLabelsItemsConfig{{&upperBug, nil, &description}},
+			&core.GhLabelsItemsConfig{{&upperBug, &red, &description}},
+		},
+		"duplicated names in from are kept": {
+			&core.GhLabelsItemsConfig{},
+			&core.GhLabelsItemsConfig{{&bug, &red, nil}, {&upperBug, &green, nil}},
+			&core.GhLabelsItemsConfig{{&bug, &red, nil}, {&upperBug, &green, nil}},
+		},
+		"to is empty": {
+			&core.GhLabelsItemsConfig{},
+			&core.GhLabelsItemsConfig{{&bug, &red, nil}},
+			&core.GhLabelsItemsConfig{{&bug, &red, nil}},
+		},
+		"from nil": {
+			&core.GhLabelsItemsConfig{{&bug, &red, nil}},
+			nil,
+			&core.GhLabelsItemsConfig{{&bug, &red, nil}},
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+				tc.value.Merge(tc.from)
+
+				if diff := cmp.Diff(tc.expected, tc.value); diff != "" {
+					t.Errorf("Config mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
+
+func TestGhLabelsItemsConfig_Get(t *testing.T) {
+	t.Parallel()
+
+	bug := "bug"
+	upperBug := "Bug"
+	feature := "feature"
+	bugLabel := &core.GhLabelConfig{Name: &bug}
+	cases := map[string]struct {
+		value    *core.GhLabelsItemsConfig
+		name     *string
+		expected *core.GhLabelConfig
+	}{
+		"nil name": {
+			&core.GhLabelsItemsConfig{bugLabel},
+			nil,
+			nil,
+		},
+		"unknown": {
+			&core.GhLabelsItemsConfig{bugLabel},
+			&feature,
+			nil,
+		},
+		"known": {
+			&core.GhLabelsItemsConfig{{}, bugLabel},
+			&bug,
+			bugLabel,
+		},
+		"case insensitive": {
+			&core.GhLabelsItemsConfig{bugLabel},
+			&upperBug,
+			bugLabel,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				if actual := tc.value.Get(tc.name); actual != tc.expected {
+					t.Errorf("Label mismatch want pointer to %p, got pointer to %p", tc.expected, actual)
+				}
+			},
+		)
+	}
+}
@@ -47,6 +47,136 @@ func EnsureErrorMatching(t *testing.T, expectedErr error, actualErr error) {
 	}
 }
 
+func GetFullTeamConfig(id int) *core.GhTeamConfig {
+	name := fmt.Sprintf("team%d", id)
+	teamTemplate := fmt.Sprintf("a-team-template%d", id)
+	description := fmt.Sprintf("a team description%d", id)
+	// Only closed teams can be nested
+	privacy := "closed"
+	parent := fmt.Sprintf("team%d", id-1)
+	maintainer := fmt.Sprintf("maintainer%d", id)
+	member := fmt.Sprintf("member%d", id)
+
+	return &core.GhTeamConfig{
+		&name,
+		&[]string{teamTemplate},
+		&description,
+		&privacy,
+		&parent,
+		&[]string{maintainer},
+		// Maintainer is expected to be managed as maintainer only
+		&[]string{member, maintainer},
+	}
+}
+
+func GetFullOrgConfig() *core.GhOrgConfig {
+	trueValue := "true"
+	falseValue := "false"
+	billingEmail := "billing@example.com"
+	name := "An organization"
+	description := "An organization description"
+	company := "A company"
+	blog := "https://example.com"
+	email := "contact@example.com"
+	twitterUsername := "an-org"
+	location := "Somewhere"
+	defaultRepositoryPermission := "read"
+
+	return &core.GhOrgConfig{
+		BillingEmail: &billingEmail,
+		Profile: &core.GhOrgProfileConfig{
+			Name:            &name,
+			Description:     &description,
+			Company:         &company,
+			Blog:            &blog,
+			Email:           &email,
+			TwitterUsername: &twitterUsername,
+			Location:        &location,
+		},
+		DefaultRepositoryPermission: &defaultRepositoryPermission,
+		WebCommitSignoff:            &trueValue,
+		Projects:                    &core.GhOrgProjectsConfig{Organization: &trueValue, Repository: &falseValue},
+		MembersCan: &core.GhOrgMembersCanConfig{
+			CreateRepositories:         &trueValue,
+			CreatePublicRepositories:   &falseValue,
+			CreatePrivateRepositories:  &trueValue,
+			CreateInternalRepositories: &falseValue,
+			CreatePages:                &trueValue,
+			CreatePublicPages:          &falseValue,
+			CreatePrivatePages:         &trueValue,
+			ForkPrivateRepositories:    &falseValue,
+		},
+		NewRepositories: &core.GhOrgNewRepositoriesConfig{
+			AdvancedSecurity:             &falseValue,
+			DependabotAlerts:             &trueValue,
+			DependabotSecurityUpdates:    &trueValue,
+			DependencyGraph:              &trueValue,
+			SecretScanning:               &trueValue,
+			SecretScanningPushProtection: &falseValue,
+		},
+	}
+}
+
+func GetFullCustomPropertiesConfig() []*core.GhCustomPropertyConfig {
+	property1 := "property1"
+	environment := "environment"
+	topics := "topics"
+	archived := "archived"
+	stringType := core.CustomPropertyStringType
+	singleSelectType := core.CustomPropertySingleSelectType
+	multiSelectType := core.CustomPropertyMultiSelectType
+	trueFalseType := core.CustomPropertyTrueFalseType
+
+	return []*core.GhCustomPropertyConfig{
+		{Name: &property1, ValueType: &stringType},
+		{Name: &environment, ValueType: &singleSelectType, AllowedValues: &[]string{"production", "staging"}},
+		{Name: &topics, ValueType: &multiSelectType, AllowedValues: &[]string{"topic1", "topic2", "topic3"}},
+		{Name: &archived, ValueType: &trueFalseType},
+	}
+}
+
+func GetFullOrgRulesetsConfig() []*core.GhOrgRulesetConfig {
+	name1 := "org-ruleset1"
+	name2 := "org-ruleset2"
+	name3 := "org-ruleset3"
+	tagTarget := "tag"
+	trueValue := "true"
+	// Re-use a full repository ruleset for the first one
+	ruleset1 := (*GetFullConfig(1).Rulesets)[0]
+	ruleset1.Name = &name1
+
+	return []*core.GhOrgRulesetConfig{
+		{
+			GhRulesetConfig: *ruleset1,
+			Repositories: &core.GhOrgRulesetRepositoriesConfig{
+				Names:    &[]string{"repo1"},
+				Topics:   &[]string{"topic2"},
+				Patterns: &[]string{"repo*"},
+				Exclude:  &[]string{"legacy-*"},
+			},
+		},
+		{
+			GhRulesetConfig: core.GhRulesetConfig{
+				Name:    &name2,
+				Target:  &tagTarget,
+				Include: &[]string{"~ALL"},
+				Rules:   &core.GhRulesetRulesConfig{RestrictDeletion: &trueValue},
+			},
+			Repositories: &core.GhOrgRulesetRepositoriesConfig{
+				Patterns: &[]string{"service-*"},
+				Exclude:  &[]string{"service-legacy"},
+			},
+		},
+		{
+			GhRulesetConfig: core.GhRulesetConfig{
+				Name:  &name3,
+				Rules: &core.GhRulesetRulesConfig{RequireSignedCommits: &trueValue},
+			},
+			Repositories: nil,
+		},
+	}
+}
+
 func GetFullConfig(id int) *core.GhRepoConfig {
 	bool1 := "false"
 	bool2 := "true"
@@ -145,7 +275,6 @@ func GetFullConfig(id int) *core.GhRepoConfig {
 	// Repo->BranchProtections[0]
 	branchProtectionTemplate := fmt.Sprintf("branch-protection-template%d", id)
 	pattern := fmt.Sprintf("a-pattern%d", id)
-	forbid := fmt.Sprintf("%s", bool2)                                //nolint:perfsprint // Because :p
 	branchProtectionEnforceAdmins := fmt.Sprintf("%s", bool2)         //nolint:perfsprint // Because :p
 	branchProtectionAllowsDeletions4 := fmt.Sprintf("%s", bool2)      //nolint:perfsprint // Because :p
 	branchProtectionRequiredLinearHistory := fmt.Sprintf("%s", bool2) //nolint:perfsprint // Because :p
@@ -165,6 +294,9 @@ func GetFullConfig(id int) *core.GhRepoConfig {
 	branchProtectionDismissStaleReviews := fmt.Sprintf("%s", bool2) //nolint:perfsprint // Because :p
 	branchProtectionRestrictDismissal := fmt.Sprintf("%s", bool2)   //nolint:perfsprint // Because :p
 	branchProtectionDismissalRestriction := fmt.Sprintf("branch-protection-dismissalRestriction%d", id)
+	// Repo->BranchProtections[1]
+	forbiddenPattern := fmt.Sprintf("forbidden-pattern%d", id)
+	forbid := fmt.Sprintf("%s", bool2) //nolint:perfsprint // Because :p
 	// Repo->PullRequests
 	// Repo->PullRequests->MergeStrategy
 	allowMergeCommit := fmt.Sprintf("%s", bool1) //nolint:perfsprint // Because :p
@@ -181,7 +313,16 @@ func GetFullConfig(id int) *core.GhRepoConfig {
 	suggestUpdate := fmt.Sprintf("%s", bool1)       //nolint:perfsprint // Because :p
 	deleteBranchOnMerge := fmt.Sprintf("%s", bool1) //nolint:perfsprint // Because :p
 	// Repo->Security
-	vulnerabilityAlerts := fmt.Sprintf("%s", bool2) //nolint:perfsprint // Because :p
+	vulnerabilityAlerts := fmt.Sprintf("%s", bool2)           //nolint:perfsprint // Because :p
+	advancedSecurity := fmt.Sprintf("%s", bool2)              //nolint:perfsprint // Because :p
+	secretScanning := fmt.Sprintf("%s", bool2)                //nolint:perfsprint // Because :p
+	secretScanningPushProtection := fmt.Sprintf("%s", bool2)  //nolint:perfsprint // Because :p
+	dependabotSecurityUpdates := fmt.Sprintf("%s", bool1)     //nolint:perfsprint // Because :p
+	privateVulnerabilityReporting := fmt.Sprintf("%s", bool1) //nolint:perfsprint // Because :p
+	// Repo->Security->CodeScanning
+	codeScanningState := "configured"
+	codeScanningQuerySuite := "default"
+	codeScanningLanguage := "go"
 	// Repo->Misc
 	archived := fmt.Sprintf("%s", bool1) //nolint:perfsprint // Because :p
 	topicCount := 2
@@ -209,6 +350,119 @@ func GetFullConfig(id int) *core.GhRepoConfig {
 	// Repo->Terraform
 	archiveOnDestroy := fmt.Sprintf("%s", bool2)                    //nolint:perfsprint // Because :p
 	ignoreVulnerabilityAlertsDuringRead := fmt.Sprintf("%s", bool2) //nolint:perfsprint // Because :p
+	previousName := fmt.Sprintf("old-repo%d", id)
+	// Repo->Teams
+	teamName := fmt.Sprintf("team%d", id)
+	teamPermission := "push"
+	sharedTeamPermission := "maintain"
+	// Repo->Collaborators
+	collaboratorName := fmt.Sprintf("user%d", id)
+	// Repo->Rulesets[0]
+	rulesetName := fmt.Sprintf("ruleset%d", id)
+	rulesetTemplate := fmt.Sprintf("ruleset-template%d", id)
+	rulesetTarget := "branch"
+	rulesetEnforcement := "active"
+	rulesetInclude := "~DEFAULT_BRANCH"
+	rulesetExclude := fmt.Sprintf("release/v%d", id)
+	// Repo->Rulesets[0]->BypassActors
+	rulesetTeamActorType := "team"
+	rulesetTeamActorMode := "pull-request"
+	rulesetRoleActorType := "repository-role"
+	rulesetRoleActorId := "admin"
+	rulesetRoleActorMode := "always"
+	// Repo->Rulesets[0]->Rules
+	rulesetRestrictCreation := fmt.Sprintf("%s", bool1)     //nolint:perfsprint // Because :p
+	rulesetRestrictUpdate := fmt.Sprintf("%s", bool1)       //nolint:perfsprint // Because :p
+	rulesetRestrictDeletion := fmt.Sprintf("%s", bool2)     //nolint:perfsprint // Because :p
+	rulesetRestrictForcePush := fmt.Sprintf("%s", bool2)    //nolint:perfsprint // Because :p
+	rulesetRequireLinearHistory := fmt.Sprintf("%s", bool1) //nolint:perfsprint // Because :p
+	rulesetRequireSignedCommits := fmt.Sprintf("%s", bool2) //nolint:perfsprint // Because :p
+	// Repo->Rulesets[0]->Rules->StatusChecks
+	rulesetStrict := fmt.Sprintf("%s", bool1) //nolint:perfsprint // Because :p
+	rulesetContext := fmt.Sprintf("ruleset-context%d", id)
+	// Repo->Rulesets[0]->Rules->PullRequestReviews
+	rulesetApprovalCount := strconv.Itoa((approvalCount + 4) % 7)
+	rulesetCodeownerApprovals := fmt.Sprintf("%s", bool1)    //nolint:perfsprint // Because :p
+	rulesetResolvedConversations := fmt.Sprintf("%s", bool2) //nolint:perfsprint // Because :p
+	rulesetLastPushApproval := fmt.Sprintf("%s", bool1)      //nolint:perfsprint // Because :p
+	rulesetDismissStaled := fmt.Sprintf("%s", bool2)         //nolint:perfsprint // Because :p
+	// Repo->Environments
+	environmentName := fmt.Sprintf("environment%d", id)
+	environmentTemplate := fmt.Sprintf("environment-template%d", id)
+	environmentWaitTimer := strconv.Itoa(id * 10)
+	environmentReviewerUser := fmt.Sprintf("100%d", id)
+	environmentReviewerTeamId := fmt.Sprintf("4%d", id)
+	environmentPreventSelfReview := fmt.Sprintf("%s", bool1) //nolint:perfsprint // Because :p
+	environmentBranchPattern := fmt.Sprintf("release/v%d", id)
+	protectedEnvironmentName := fmt.Sprintf("protected-environment%d", id)
+	protectedEnvironmentProtectedOnly := "true"
+	environmentVariableValue := fmt.Sprintf("env-value%d", id)
+	environmentSecretVariable := "env-shared-secret"
+	environmentSecretEncrypted := fmt.Sprintf("%s", bool1) //nolint:perfsprint // Because :p
+	// Repo->Actions
+	actionsVariableName := fmt.Sprintf("VARIABLE%d", id)
+	actionsVariableValue := fmt.Sprintf("value%d", id)
+	actionsSharedVariableValue := fmt.Sprintf("shared-value%d", id)
+	actionsSecretName := fmt.Sprintf("SECRET%d", id)
+	actionsSharedSecretVariable := "shared-secret"
+	actionsSharedSecretEncrypted := fmt.Sprintf("%s", bool2) //nolint:perfsprint // Because :p
+	actionsEnabled := "true"
+	actionsAllowedActions := "selected"
+	actionsGithubOwnedAllowed := fmt.Sprintf("%s", bool1) //nolint:perfsprint // Because :p
+	actionsVerifiedAllowed := fmt.Sprintf("%s", bool2)    //nolint:perfsprint // Because :p
+	actionsPattern := fmt.Sprintf("an-org/action%d@*", id)
+	actionsDefaultWorkflowPermissions := "read"
+	actionsCanApprovePullRequestReviews := fmt.Sprintf("%s", bool1) //nolint:perfsprint // Because :p
+	// Repo->Webhooks
+	webhookName := fmt.Sprintf("ci%d", id)
+	webhookTemplate := fmt.Sprintf("webhook-template%d", id)
+	webhookUrl := fmt.Sprintf("https://ci%d.example.com/hook", id)
+	webhookContentType := "json"
+	webhookEvent := fmt.Sprintf("event%d", id)
+	webhookActive := fmt.Sprintf("%s", bool2)      //nolint:perfsprint // Because :p
+	webhookInsecureSsl := fmt.Sprintf("%s", bool1) //nolint:perfsprint // Because :p
+	webhookSecret := fmt.Sprintf("webhook-secret%d", id)
+	sharedWebhookUrl := "https://chat.example.com/hook"
+	// Repo->Labels
+	labelsTemplate := fmt.Sprintf("labels-template%d", id)
+	labelsAuthoritative := fmt.Sprintf("%s", bool2) //nolint:perfsprint // Because :p
+	labelName := fmt.Sprintf("label%d", id)
+	labelColor := fmt.Sprintf("00000%d", id)
+	labelDescription := fmt.Sprintf("a label description%d", id)
+	sharedLabelName := "bug"
+	sharedLabelColor := "d73a4a"
+	// Repo->Files
+	filePath := fmt.Sprintf("docs/file%d.md", id)
+	fileSource := fmt.Sprintf("file%d.md", id)
+	fileBranch := fmt.Sprintf("master%d", id)
+	fileCommitMessage := fmt.Sprintf("a commit message%d", id)
+	fileOverwriteOnCreate := fmt.Sprintf("%s", bool1) //nolint:perfsprint // Because :p
+	// Repo->DeployKeys
+	deployKeyBlob := "AAAAC3NzaC1lZDI1NTE5AAAAIMkep8EAvhF//MDLWxu14eveA+xy2SbuAusenR2X7Kfb"
+	deployKeyTitle := fmt.Sprintf("deploy-key%d", id)
+	deployKeyReadOnly := fmt.Sprintf("%s", bool2) //nolint:perfsprint // Because :p
+	deployKeyFile := fmt.Sprintf("testdata/deploy-keys/deploy-key%d.pub", id)
+	deployKey := fmt.Sprintf("ssh-ed25519 %s deploy-key%d@example.com", deployKeyBlob, id)
+	sharedDeployKeyTitle := "shared-deploy-key"
+	sharedDeployKey := fmt.Sprintf("ssh-ed25519 %s shared@example.com", deployKeyBlob)
+	// Repo->Autolinks
+	autolinkKeyPrefix := fmt.Sprintf("TICKET%d-", id)
+	autolinkUrlTemplate := fmt.Sprintf("https://tickets%d.example.com/<num>", id)
+	autolinkAlphanumeric := fmt.Sprintf("%s", bool1) //nolint:perfsprint // Because :p
+	// Repo->CustomProperties
+	customPropertyName := fmt.Sprintf("property%d", id)
+	customPropertyValue := fmt.Sprintf("value%d", id)
+
+	if id%2 == 0 {
+		teamPermission = "triage"
+		sharedTeamPermission = "admin"
+		rulesetTarget = "tag"
+		rulesetEnforcement = "evaluate"
+		actionsDefaultWorkflowPermissions = "write"
+		codeScanningState = "not-configured"
+		codeScanningQuerySuite = "extended"
+		codeScanningLanguage = "python"
+	}
 
 	return &core.GhRepoConfig{
 		&name,
@@ -318,7 +572,7 @@ func GetFullConfig(id int) *core.GhRepoConfig {
 		&core.GhBranchProtectionsConfig{
 			{
 				&pattern,
-				&forbid,
+				nil,
 				core.BaseGhBranchProtectionConfig{
 					&[]string{branchProtectionTemplate},
 					&branchProtectionEnforceAdmins,
@@ -346,6 +600,11 @@ func GetFullConfig(id int) *core.GhRepoConfig {
 					},
 				},
 			},
+			{
+				&forbiddenPattern,
+				&forbid,
+				core.BaseGhBranchProtectionConfig{}, //nolint:exhaustruct // Nothing else than forbid
+			},
 		},
 		&core.GhRepoPullRequestConfig{
 			&core.GhRepoPRMergeStrategyConfig{
@@ -367,7 +626,19 @@ func GetFullConfig(id int) *core.GhRepoConfig {
 				&deleteBranchOnMerge,
 			},
 		},
-		&core.GhRepoSecurityConfig{&vulnerabilityAlerts},
+		&core.GhRepoSecurityConfig{
+			&vulnerabilityAlerts,
+			&advancedSecurity,
+			&secretScanning,
+			&secretScanningPushProtection,
+			&dependabotSecurityUpdates,
+			&privateVulnerabilityReporting,
+			&core.GhRepoCodeScanningConfig{
+				&codeScanningState,
+				&codeScanningQuerySuite,
+				&[]string{codeScanningLanguage},
+			},
+		},
 		&core.GhRepoMiscellaneousConfig{
 			&[]string{topic1, topic2},
 			&autoInit,
@@ -392,6 +663,139 @@ func GetFullConfig(id int) *core.GhRepoConfig {
 				&license,
 			},
 		},
-		&core.GhRepoTerraformConfig{&archiveOnDestroy, &ignoreVulnerabilityAlertsDuringRead},
+		&core.GhRepoTerraformConfig{&archiveOnDestroy, &ignoreVulnerabilityAlertsDuringRead, &[]string{previousName}},
+		&core.GhPermissionsConfig{teamName: teamPermission, "shared-team": sharedTeamPermission},
+		&core.GhPermissionsConfig{collaboratorName: "pull"},
+		&core.GhRulesetsConfig{
+			{
+				&rulesetName,
+				&[]string{rulesetTemplate},
+				&rulesetTarget,
+				&rulesetEnforcement,
+				&[]string{rulesetInclude},
+				&[]string{rulesetExclude},
+				&core.GhRulesetBypassActorsConfig{
+					{&rulesetTeamActorType, &teamName, &rulesetTeamActorMode},
+					{&rulesetRoleActorType, &rulesetRoleActorId, &rulesetRoleActorMode},
+				},
+				&core.GhRulesetRulesConfig{
+					&rulesetRestrictCreation,
+					&rulesetRestrictUpdate,
+					&rulesetRestrictDeletion,
+					&rulesetRestrictForcePush,
+					&rulesetRequireLinearHistory,
+					&rulesetRequireSignedCommits,
+					&core.GhBranchProtectStatusChecksConfig{
+						&rulesetStrict,
+						&[]string{rulesetContext},
+					},
+					&core.GhRulesetPRReviewConfig{
+						&rulesetApprovalCount,
+						&rulesetCodeownerApprovals,
+						&rulesetResolvedConversations,
+						&rulesetLastPushApproval,
+						&rulesetDismissStaled,
+					},
+				},
+			},
+		},
+		&core.GhEnvironmentsConfig{
+			environmentName: {
+				&[]string{environmentTemplate},
+				&environmentWaitTimer,
+				&core.GhEnvironmentReviewersConfig{
+					&[]string{environmentReviewerUser},
+					&[]string{teamName, environmentReviewerTeamId},
+				},
+				&environmentPreventSelfReview,
+				&core.GhEnvironmentDeploymentBranchesConfig{nil, &[]string{environmentBranchPattern, "main"}},
+				&core.GhActionsConfig{
+					&core.GhActionsVariablesConfig{"ENV_VARIABLE": environmentVariableValue},
+					&core.GhActionsSecretsConfig{
+						"ENV_SECRET":        nil,
+						"ENV_SHARED_SECRET": {&environmentSecretVariable, &environmentSecretEncrypted},
+					},
+				},
+			},
+			protectedEnvironmentName: {
+				nil,
+				nil,
+				nil,
+				nil,
+				&core.GhEnvironmentDeploymentBranchesConfig{&protectedEnvironmentProtectedOnly, nil},
+				nil,
+			},
+		},
+		&core.GhRepoActionsConfig{
+			core.GhActionsConfig{
+				&core.GhActionsVariablesConfig{
+					actionsVariableName: actionsVariableValue,
+					"SHARED_VARIABLE":   actionsSharedVariableValue,
+				},
+				&core.GhActionsSecretsConfig{
+					actionsSecretName: nil,
+					"SHARED_SECRET":   {&actionsSharedSecretVariable, &actionsSharedSecretEncrypted},
+				},
+			},
+			&core.GhActionsPermissionsConfig{
+				&actionsEnabled,
+				&actionsAllowedActions,
+				&actionsGithubOwnedAllowed,
+				&actionsVerifiedAllowed,
+				&[]string{actionsPattern},
+				&actionsDefaultWorkflowPermissions,
+				&actionsCanApprovePullRequestReviews,
+			},
+		},
+		&core.GhWebhooksConfig{
+			{
+				&webhookName,
+				&[]string{webhookTemplate},
+				&webhookUrl,
+				&webhookContentType,
+				&[]string{"push", webhookEvent},
+				&webhookActive,
+				&webhookInsecureSsl,
+				&webhookSecret,
+			},
+			{nil, nil, &sharedWebhookUrl, nil, &[]string{"release"}, nil, nil, nil},
+		},
+		&core.GhLabelsConfig{
+			&[]string{labelsTemplate},
+			&labelsAuthoritative,
+			&core.GhLabelsItemsConfig{
+				{&labelName, &labelColor, &labelDescription},
+				{&sharedLabelName, &sharedLabelColor, nil},
+			},
+		},
+		&core.GhRepoFilesConfig{
+			filePath: {
+				&fileSource,
+				&fileBranch,
+				&fileCommitMessage,
+				&fileOverwriteOnCreate,
+				&[]string{"content", "commit-message"},
+			},
+			"SECURITY.md": nil,
+		},
+		&core.GhRepoDeployKeysConfig{
+			{&deployKeyTitle, &deployKeyReadOnly, &deployKeyFile, &deployKey},
+			{&sharedDeployKeyTitle, nil, nil, &sharedDeployKey},
+		},
+		&core.GhRepoAutolinksConfig{
+			autolinkKeyPrefix: {&autolinkUrlTemplate, &autolinkAlphanumeric},
+		},
+		&core.GhRepoCustomPropertiesConfig{
+			customPropertyName: {customPropertyValue},
+			"topics":           {"topic1", "topic2"},
+		},
 	}
 }
+
+// GetFullLoadedConfig returns GetFullConfig as loaded from YAML files, deploy key files are loaded by ComputeRepoConfig.
+func GetFullLoadedConfig(id int) *core.GhRepoConfig {
+	config := GetFullConfig(id)
+	(*config.DeployKeys)[0].Key = nil
+
+	return config
+}
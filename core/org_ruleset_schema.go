@@ -0,0 +1,13 @@
+package core
+
+type GhOrgRulesetConfig struct {
+	GhRulesetConfig `yaml:",inline"`
+	Repositories    *GhOrgRulesetRepositoriesConfig `yaml:"repositories,omitempty"`
+}
+
+type GhOrgRulesetRepositoriesConfig struct {
+	Names    *[]string `yaml:"names,omitempty,flow"`
+	Topics   *[]string `yaml:"topics,omitempty,flow"`
+	Patterns *[]string `yaml:"patterns,omitempty,flow"`
+	Exclude  *[]string `yaml:"exclude,omitempty,flow"`
+}
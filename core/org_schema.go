@@ -0,0 +1,47 @@
+package core
+
+type GhOrgConfig struct {
+	BillingEmail                *string                     `yaml:"billing-email,omitempty"`
+	Profile                     *GhOrgProfileConfig         `yaml:"profile,omitempty"`
+	DefaultRepositoryPermission *string                     `yaml:"default-repository-permission,omitempty"`
+	WebCommitSignoff            *string                     `yaml:"web-commit-signoff,omitempty"`
+	Projects                    *GhOrgProjectsConfig        `yaml:"projects,omitempty"`
+	MembersCan                  *GhOrgMembersCanConfig      `yaml:"members-can,omitempty"`
+	NewRepositories             *GhOrgNewRepositoriesConfig `yaml:"new-repositories,omitempty"`
+}
+
+type GhOrgProfileConfig struct {
+	Name            *string `yaml:"name,omitempty"`
+	Description     *string `yaml:"description,omitempty"`
+	Company         *string `yaml:"company,omitempty"`
+	Blog            *string `yaml:"blog,omitempty"`
+	Email           *string `yaml:"email,omitempty"`
+	TwitterUsername *string `yaml:"twitter-username,omitempty"`
+	Location        *string `yaml:"location,omitempty"`
+}
+
+type GhOrgProjectsConfig struct {
+	Organization *string `yaml:"organization,omitempty"`
+	Repository   *string `yaml:"repository,omitempty"`
+}
+
+type GhOrgMembersCanConfig struct {
+	CreateRepositories         *string `yaml:"create-repositories,omitempty"`
+	CreatePublicRepositories   *string `yaml:"create-public-repositories,omitempty"`
+	CreatePrivateRepositories  *string `yaml:"create-private-repositories,omitempty"`
+	CreateInternalRepositories *string `yaml:"create-internal-repositories,omitempty"`
+	CreatePages                *string `yaml:"create-pages,omitempty"`
+	CreatePublicPages          *string `yaml:"create-public-pages,omitempty"`
+	CreatePrivatePages         *string `yaml:"create-private-pages,omitempty"`
+	ForkPrivateRepositories    *string `yaml:"fork-private-repositories,omitempty"`
+}
+
+// GhOrgNewRepositoriesConfig contains security features enabled by default for new repositories.
+type GhOrgNewRepositoriesConfig struct {
+	AdvancedSecurity             *string `yaml:"advanced-security,omitempty"`
+	DependabotAlerts             *string `yaml:"dependabot-alerts,omitempty"`
+	DependabotSecurityUpdates    *string `yaml:"dependabot-security-updates,omitempty"`
+	DependencyGraph              *string `yaml:"dependency-graph,omitempty"`
+	SecretScanning               *string `yaml:"secret-scanning,omitempty"`
+	SecretScanningPushProtection *string `yaml:"secret-scanning-push-protection,omitempty"`
+}
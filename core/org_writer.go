@@ -0,0 +1,42 @@
+package core
+
+import (
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+/** Public **/
+
+func NewHclOrg(orgConfig *GhOrgConfig, valGen tfsig.ValueGenerator) *hclwrite.File {
+	hclFile := hclwrite.NewEmptyFile()
+
+	if sig := NewOrganizationSettingsSignature(MapToOrganizationSettingsRes(orgConfig, valGen)); sig != nil {
+		tfsig.AppendBlockIfNotNil(hclFile.Body(), sig.Build())
+	}
+
+	return hclFile
+}
+
+func NewHclOrgRulesets(
+	rulesetConfigs []*GhOrgRulesetConfig,
+	valGen tfsig.ValueGenerator,
+	repos []*GhRepoConfig,
+	ctx *WorkspaceContext,
+) *hclwrite.File {
+	hclFile := hclwrite.NewEmptyFile()
+	body := hclFile.Body()
+
+	for _, rulesetConfig := range rulesetConfigs {
+		res := MapToOrganizationRulesetRes(rulesetConfig, valGen, repos, ctx)
+		if sig := NewOrganizationRulesetSignature(res); sig != nil {
+			if len(body.Blocks()) > 0 {
+				body.AppendNewline()
+			}
+
+			tfsig.AppendBlockIfNotNil(body, sig.Build())
+		}
+	}
+
+	return hclFile
+}
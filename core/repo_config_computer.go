@@ -1,6 +1,13 @@
 package core
 
 import (
+	"encoding/base64"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
 	"github.com/rs/zerolog/log"
 )
 
@@ -40,6 +47,46 @@ func ComputeRepoConfig(base *GhRepoConfig, templates *TemplatesConfig) (*GhRepoC
 		return nil, err
 	}
 
+	if err = ApplyRulesetsTemplate(config, templates); err != nil {
+		return nil, err
+	}
+
+	if err = ApplyEnvironmentsTemplate(config, templates); err != nil {
+		return nil, err
+	}
+
+	if err = ApplyWebhooksTemplate(config, templates); err != nil {
+		return nil, err
+	}
+
+	if err = ApplyLabelsTemplate(config, templates); err != nil {
+		return nil, err
+	}
+
+	if err = checkForbiddenBranchProtections(config); err != nil {
+		return nil, err
+	}
+
+	if err = checkSecurityConfig(config); err != nil {
+		return nil, SecurityError(err)
+	}
+
+	if err = loadDeployKeyFiles(config); err != nil {
+		return nil, err
+	}
+
+	if err = checkDeployKeys(config); err != nil {
+		return nil, err
+	}
+
+	if err = checkWebhooks(config); err != nil {
+		return nil, err
+	}
+
+	if err = checkAutolinks(config); err != nil {
+		return nil, err
+	}
+
 	ConfigTrace("Final config: "+(*base.Name), config)
 
 	return config, nil
@@ -149,6 +196,149 @@ func ApplyBranchProtectionTemplate(
 	return applyBranchProtectionTemplate(branchProtectionConfig, tplList), nil
 }
 
+func ApplyRulesetsTemplate(config *GhRepoConfig, templates *TemplatesConfig) error {
+	if config == nil || config.Rulesets == nil {
+		return nil
+	}
+
+	var err error
+
+	for k, r := range *config.Rulesets {
+		if r, err = ApplyRulesetTemplate(r, templates); err != nil {
+			return RulesetError(k, err)
+		}
+
+		(*config.Rulesets)[k] = r
+	}
+
+	mapDuplicatedRuleset(config)
+
+	return nil
+}
+
+func ApplyRulesetTemplate(rulesetConfig *GhRulesetConfig, templates *TemplatesConfig) (*GhRulesetConfig, error) {
+	if rulesetConfig == nil {
+		return rulesetConfig, nil
+	}
+
+	tplList, err := loadRulesetTemplatesFor(rulesetConfig.ConfigTemplates, templates)
+	if err != nil {
+		return nil, err
+	}
+
+	return applyRulesetTemplate(rulesetConfig, tplList), nil
+}
+
+func ApplyEnvironmentsTemplate(config *GhRepoConfig, templates *TemplatesConfig) error {
+	if config == nil || config.Environments == nil {
+		return nil
+	}
+
+	var err error
+
+	for k, e := range *config.Environments {
+		if e, err = ApplyEnvironmentTemplate(e, templates); err != nil {
+			return EnvironmentError(k, err)
+		}
+
+		(*config.Environments)[k] = e
+	}
+
+	return nil
+}
+
+func ApplyEnvironmentTemplate(
+	environmentConfig *GhEnvironmentConfig,
+	templates *TemplatesConfig,
+) (*GhEnvironmentConfig, error) {
+	if environmentConfig == nil {
+		return environmentConfig, nil
+	}
+
+	tplList, err := loadEnvironmentTemplatesFor(environmentConfig.ConfigTemplates, templates)
+	if err != nil {
+		return nil, err
+	}
+
+	return applyEnvironmentTemplate(environmentConfig, tplList), nil
+}
+
+// ApplyWebhooksTemplate applies templates of each webhook, and merges webhooks sharing the same URL.
+//
+// An error is returned if a webhook URL is still undefined once templates are applied.
+func ApplyWebhooksTemplate(config *GhRepoConfig, templates *TemplatesConfig) error {
+	if config == nil || config.Webhooks == nil {
+		return nil
+	}
+
+	var err error
+
+	for k, w := range *config.Webhooks {
+		if w, err = ApplyWebhookTemplate(w, templates); err != nil {
+			return WebhookError(k, err)
+		}
+
+		if w != nil && w.Url == nil {
+			return WebhookError(k, ErrWebhookUrlIsMandatory)
+		}
+
+		(*config.Webhooks)[k] = w
+	}
+
+	mapDuplicatedWebhook(config)
+
+	return nil
+}
+
+func ApplyWebhookTemplate(webhookConfig *GhWebhookConfig, templates *TemplatesConfig) (*GhWebhookConfig, error) {
+	if webhookConfig == nil {
+		return webhookConfig, nil
+	}
+
+	tplList, err := loadWebhookTemplatesFor(webhookConfig.ConfigTemplates, templates)
+	if err != nil {
+		return nil, err
+	}
+
+	return applyWebhookTemplate(webhookConfig, tplList), nil
+}
+
+// ApplyLabelsTemplate applies labels templates, labels sharing the same name (case-insensitive) are merged.
+//
+// An error is returned if a labels list declares names differing only by their case, or if a label color is still
+// undefined once templates are applied.
+func ApplyLabelsTemplate(config *GhRepoConfig, templates *TemplatesConfig) error {
+	if config == nil || config.Labels == nil {
+		return nil
+	}
+
+	tplList, err := loadLabelsTemplatesFor(config.Labels.ConfigTemplates, templates)
+	if err != nil {
+		return LabelsError(err)
+	}
+
+	// Duplicates must be checked before merge, as merge combines them
+	for _, labelsConfig := range append([]*GhLabelsConfig{config.Labels}, tplList...) {
+		if err = checkLabelNames(labelsConfig.Items); err != nil {
+			return LabelsError(err)
+		}
+	}
+
+	config.Labels = applyLabelsTemplate(config.Labels, tplList)
+
+	if config.Labels.Items == nil {
+		return nil
+	}
+
+	for _, labelConfig := range *config.Labels.Items {
+		if labelConfig != nil && labelConfig.Name != nil && labelConfig.Color == nil {
+			return LabelsError(LabelError(*labelConfig.Name, ErrLabelColorIsMandatory))
+		}
+	}
+
+	return nil
+}
+
 func ApplyBranchTemplate(branchConfig *GhBranchConfig, templates *TemplatesConfig) (*GhBranchConfig, error) {
 	if branchConfig == nil {
 		return branchConfig, nil
@@ -164,6 +354,182 @@ func ApplyBranchTemplate(branchConfig *GhBranchConfig, templates *TemplatesConfi
 
 /** Private **/
 
+// checkSecurityConfig checks settings which may come from different templates, and so can't be validated by schemas.
+func checkSecurityConfig(repoConfig *GhRepoConfig) error {
+	config := repoConfig.Security
+	if config == nil {
+		return nil
+	}
+
+	if config.SecretScanningPushProtection != nil && *config.SecretScanningPushProtection == "true" &&
+		(config.SecretScanning == nil || *config.SecretScanning != "true") {
+		return ErrSecretScanningIsMandatory
+	}
+
+	// Private and internal repositories require GitHub Advanced Security for code scanning
+	if config.CodeScanning != nil && config.CodeScanning.State != nil && *config.CodeScanning.State == "configured" &&
+		repoConfig.Visibility != nil && *repoConfig.Visibility != "public" &&
+		(config.AdvancedSecurity == nil || *config.AdvancedSecurity != "true") {
+		return ErrAdvancedSecurityMandatory
+	}
+
+	return nil
+}
+
+// checkForbiddenBranchProtections ensures forbidden branch protections don't allow any kind of push or deletion.
+func checkForbiddenBranchProtections(config *GhRepoConfig) error {
+	if config.BranchProtections == nil {
+		return nil
+	}
+
+	for k, branchProtectionConfig := range *config.BranchProtections {
+		if !isForbiddenBranchProtection(branchProtectionConfig) {
+			continue
+		}
+
+		if err := checkForbiddenBranchProtection(branchProtectionConfig); err != nil {
+			return BranchProtectionError(k, err)
+		}
+	}
+
+	return nil
+}
+
+func checkForbiddenBranchProtection(branchProtectionConfig *GhBranchProtectionConfig) error {
+	if branchProtectionConfig.AllowDeletion != nil && *branchProtectionConfig.AllowDeletion == "true" {
+		return ForbiddenBranchConflictError("deletion")
+	}
+
+	if pushes := branchProtectionConfig.Pushes; pushes != nil {
+		if pushes.AllowsForcePushes != nil && *pushes.AllowsForcePushes == "true" {
+			return ForbiddenBranchConflictError("pushes.force-push")
+		}
+
+		if pushes.RestrictTo != nil && len(*pushes.RestrictTo) > 0 {
+			return ForbiddenBranchConflictError("pushes.restrict-to")
+		}
+	}
+
+	return nil
+}
+
+// checkDeployKeys ensures titles are unique, as they are used as terraform identifiers.
+func checkDeployKeys(config *GhRepoConfig) error {
+	if config.DeployKeys == nil {
+		return nil
+	}
+
+	knownTitles := map[string]bool{}
+
+	for k, deployKeyConfig := range *config.DeployKeys {
+		if deployKeyConfig == nil || deployKeyConfig.Title == nil {
+			continue
+		}
+
+		if knownTitles[*deployKeyConfig.Title] {
+			return DeployKeyError(k, ErrDeployKeyAlreadyDeclared)
+		}
+
+		knownTitles[*deployKeyConfig.Title] = true
+	}
+
+	return nil
+}
+
+// loadDeployKeyFiles loads deploy keys from their key file and ensures they are valid OpenSSH public keys.
+func loadDeployKeyFiles(config *GhRepoConfig) error {
+	if config == nil || config.DeployKeys == nil {
+		return nil
+	}
+
+	for k, deployKeyConfig := range *config.DeployKeys {
+		if deployKeyConfig == nil {
+			continue
+		}
+
+		if deployKeyConfig.KeyFile != nil {
+			keyFilePath := *deployKeyConfig.KeyFile
+			if WorkspaceDirectory != nil {
+				keyFilePath = filepath.Join(*WorkspaceDirectory, keyFilePath)
+			}
+
+			content, err := os.ReadFile(keyFilePath)
+			if err != nil {
+				return DeployKeyError(k, DeployKeyFileNotFoundError(*deployKeyConfig.KeyFile))
+			}
+
+			key := strings.TrimSpace(string(content))
+			deployKeyConfig.Key = &key
+		}
+
+		if deployKeyConfig.Key != nil && !isOpenSshPublicKey(*deployKeyConfig.Key) {
+			return DeployKeyError(k, ErrInvalidOpenSshPublicKey)
+		}
+	}
+
+	return nil
+}
+
+// isOpenSshPublicKey checks the "<type> <base64 blob> [comment]" format, blob must start with the key type.
+func isOpenSshPublicKey(key string) bool {
+	const lengthSize = 4
+
+	fields := strings.Fields(key)
+	if len(fields) < 2 || strings.Contains(strings.TrimSpace(key), "\n") ||
+		!slices.Contains(openSshPublicKeyTypes, fields[0]) {
+		return false
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil || len(blob) < lengthSize {
+		return false
+	}
+
+	typeLength := uint64(binary.BigEndian.Uint32(blob[:lengthSize]))
+
+	return uint64(len(blob)-lengthSize) >= typeLength && string(blob[lengthSize:lengthSize+typeLength]) == fields[0]
+}
+
+// checkWebhooks ensures terraform identifiers are unique, as names may be identical once converted to identifiers.
+func checkWebhooks(config *GhRepoConfig) error {
+	if config.Webhooks == nil {
+		return nil
+	}
+
+	knownIds := map[string]bool{}
+
+	for k, webhookConfig := range *config.Webhooks {
+		if webhookConfig == nil || webhookConfig.Url == nil {
+			continue
+		}
+
+		tfId := mapWebhookTfId("", webhookConfig)
+		if knownIds[tfId] {
+			return WebhookError(k, ErrWebhookAlreadyDeclared)
+		}
+
+		knownIds[tfId] = true
+	}
+
+	return nil
+}
+
+// checkAutolinks ensures URL templates are provided once templates are applied.
+func checkAutolinks(config *GhRepoConfig) error {
+	if config.Autolinks == nil {
+		return nil
+	}
+
+	keys, autolinks := MapToSortedListWithKeys(*config.Autolinks)
+	for idx, autolinkConfig := range autolinks {
+		if autolinkConfig != nil && autolinkConfig.UrlTemplate == nil {
+			return AutolinkError(keys[idx], ErrAutolinkUrlIsMandatory)
+		}
+	}
+
+	return nil
+}
+
 // Not easily doable with json-schema and applying templates might create duplicates.
 func mapDuplicatedBranchProtection(conf *GhRepoConfig) {
 	if conf.BranchProtections != nil {
@@ -195,6 +561,66 @@ func mapDuplicatedBranchProtection(conf *GhRepoConfig) {
 	}
 }
 
+// Same as mapDuplicatedBranchProtection but for rulesets, based on the name.
+func mapDuplicatedRuleset(conf *GhRepoConfig) {
+	knownName := map[string]int{}
+	configs := conf.Rulesets
+
+	for idx := 0; idx < len(*configs); idx++ {
+		rulesetConfig := (*configs)[idx]
+		if rulesetConfig.Name == nil {
+			continue
+		}
+
+		if knownKey, ok := knownName[*rulesetConfig.Name]; ok {
+			log.Warn().Msgf(
+				"Repository %s: A ruleset named '%s' already exists (#%d) => applying #%d as template for #%d !",
+				*conf.Name,
+				*rulesetConfig.Name,
+				knownKey,
+				knownKey,
+				idx,
+			)
+
+			(*configs)[knownKey] = applyRulesetTemplate(rulesetConfig, []*GhRulesetConfig{(*configs)[knownKey]})
+			*configs = append((*configs)[:idx], (*configs)[idx+1:]...) // Remove the existing config from the list
+			idx--
+		} else {
+			knownName[*rulesetConfig.Name] = idx
+		}
+	}
+}
+
+// Same as mapDuplicatedBranchProtection but for webhooks, based on the URL.
+func mapDuplicatedWebhook(conf *GhRepoConfig) {
+	knownUrl := map[string]int{}
+	configs := conf.Webhooks
+
+	for idx := 0; idx < len(*configs); idx++ {
+		webhookConfig := (*configs)[idx]
+		if webhookConfig == nil || webhookConfig.Url == nil {
+			continue
+		}
+
+		if knownKey, ok := knownUrl[*webhookConfig.Url]; ok {
+			log.Warn().Msgf(
+				"Repository %s: A webhook with '%s' URL already exists (#%d) => applying #%d as template for #%d !",
+				*conf.Name,
+				*webhookConfig.Url,
+				knownKey,
+				knownKey,
+				idx,
+			)
+
+			(*configs)[knownKey] = applyWebhookTemplate(webhookConfig, []*GhWebhookConfig{(*configs)[knownKey]})
+			*configs = append((*configs)[:idx], (*configs)[idx+1:]...) // Remove the existing config from the list
+			idx--
+		} else {
+			knownUrl[*webhookConfig.Url] = idx
+		}
+	}
+}
+
 func applyRepositoryTemplate(toConfig *GhRepoConfig, tplList []*GhRepoConfig) *GhRepoConfig {
 	if len(tplList) == 0 {
 		return toConfig
@@ -255,6 +681,85 @@ func applyBranchProtectionTemplate(
 	return newConfig
 }
 
+func applyRulesetTemplate(configReceiver *GhRulesetConfig, tplList []*GhRulesetConfig) *GhRulesetConfig {
+	if len(tplList) == 0 {
+		return configReceiver
+	}
+
+	//nolint:exhaustruct // No need here, it's base structure
+	newConfig := &GhRulesetConfig{}
+
+	for _, tpl := range tplList {
+		newConfig.Merge(tpl)
+	}
+
+	newConfig.Merge(configReceiver)
+	// Remove templates as they are applied
+	newConfig.ConfigTemplates = nil
+
+	return newConfig
+}
+
+func applyEnvironmentTemplate(
+	configReceiver *GhEnvironmentConfig,
+	tplList []*GhEnvironmentConfig,
+) *GhEnvironmentConfig {
+	if len(tplList) == 0 {
+		return configReceiver
+	}
+
+	//nolint:exhaustruct // No need here, it's base structure
+	newConfig := &GhEnvironmentConfig{}
+
+	for _, tpl := range tplList {
+		newConfig.Merge(tpl)
+	}
+
+	newConfig.Merge(configReceiver)
+	// Remove templates as they are applied
+	newConfig.ConfigTemplates = nil
+
+	return newConfig
+}
+
+func applyWebhookTemplate(configReceiver *GhWebhookConfig, tplList []*GhWebhookConfig) *GhWebhookConfig {
+	if len(tplList) == 0 {
+		return configReceiver
+	}
+
+	//nolint:exhaustruct // No need here, it's base structure
+	newConfig := &GhWebhookConfig{}
+
+	for _, tpl := range tplList {
+		newConfig.Merge(tpl)
+	}
+
+	newConfig.Merge(configReceiver)
+	// Remove templates as they are applied
+	newConfig.ConfigTemplates = nil
+
+	return newConfig
+}
+
+func applyLabelsTemplate(configReceiver *GhLabelsConfig, tplList []*GhLabelsConfig) *GhLabelsConfig {
+	if len(tplList) == 0 {
+		return configReceiver
+	}
+
+	//nolint:exhaustruct // No need here, it's base structure
+	newConfig := &GhLabelsConfig{}
+
+	for _, tpl := range tplList {
+		newConfig.Merge(tpl)
+	}
+
+	newConfig.Merge(configReceiver)
+	// Remove templates as they are applied
+	newConfig.ConfigTemplates = nil
+
+	return newConfig
+}
+
 func loadRepoTemplatesFor(toConfig *GhRepoConfig, templates *TemplatesConfig) ([]*GhRepoConfig, error) {
 	if toConfig.ConfigTemplates == nil {
 		return nil, nil
@@ -336,6 +841,137 @@ func loadBranchTemplatesFor(tplNameToLoad *[]string, templates *TemplatesConfig)
 	return tplList, nil
 }
 
+func loadRulesetTemplatesFor(tplNameToLoad *[]string, templates *TemplatesConfig) ([]*GhRulesetConfig, error) {
+	if tplNameToLoad == nil {
+		return nil, nil
+	}
+
+	if templates == nil {
+		return nil, NoTemplateAvailableError(RulesetTemplateType)
+	}
+
+	tplList, err := LoadTemplateList(
+		tplNameToLoad,
+		func(s string) *GhRulesetConfig {
+			return templates.GetRuleset(s)
+		},
+		func(c *GhRulesetConfig) *[]string {
+			return c.ConfigTemplates
+		},
+		RulesetTemplateType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return tplList, nil
+}
+
+func loadEnvironmentTemplatesFor(
+	tplNameToLoad *[]string,
+	templates *TemplatesConfig,
+) ([]*GhEnvironmentConfig, error) {
+	if tplNameToLoad == nil {
+		return nil, nil
+	}
+
+	if templates == nil {
+		return nil, NoTemplateAvailableError(EnvironmentTemplateType)
+	}
+
+	tplList, err := LoadTemplateList(
+		tplNameToLoad,
+		func(s string) *GhEnvironmentConfig {
+			return templates.GetEnvironment(s)
+		},
+		func(c *GhEnvironmentConfig) *[]string {
+			return c.ConfigTemplates
+		},
+		EnvironmentTemplateType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return tplList, nil
+}
+
+func loadWebhookTemplatesFor(tplNameToLoad *[]string, templates *TemplatesConfig) ([]*GhWebhookConfig, error) {
+	if tplNameToLoad == nil {
+		return nil, nil
+	}
+
+	if templates == nil {
+		return nil, NoTemplateAvailableError(WebhookTemplateType)
+	}
+
+	tplList, err := LoadTemplateList(
+		tplNameToLoad,
+		func(s string) *GhWebhookConfig {
+			return templates.GetWebhook(s)
+		},
+		func(c *GhWebhookConfig) *[]string {
+			return c.ConfigTemplates
+		},
+		WebhookTemplateType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return tplList, nil
+}
+
+func checkLabelNames(items *GhLabelsItemsConfig) error {
+	if items == nil {
+		return nil
+	}
+
+	knownNames := map[string]bool{}
+
+	for _, labelConfig := range *items {
+		if labelConfig == nil || labelConfig.Name == nil {
+			continue
+		}
+
+		// GitHub label names are case-insensitive
+		lowerName := strings.ToLower(*labelConfig.Name)
+		if knownNames[lowerName] {
+			return LabelError(*labelConfig.Name, ErrLabelAlreadyDeclared)
+		}
+
+		knownNames[lowerName] = true
+	}
+
+	return nil
+}
+
+func loadLabelsTemplatesFor(tplNameToLoad *[]string, templates *TemplatesConfig) ([]*GhLabelsConfig, error) {
+	if tplNameToLoad == nil {
+		return nil, nil
+	}
+
+	if templates == nil {
+		return nil, NoTemplateAvailableError(LabelsTemplateType)
+	}
+
+	tplList, err := LoadTemplateList(
+		tplNameToLoad,
+		func(s string) *GhLabelsConfig {
+			return templates.GetLabels(s)
+		},
+		func(c *GhLabelsConfig) *[]string {
+			return c.ConfigTemplates
+		},
+		LabelsTemplateType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return tplList, nil
+}
+
 func applyBranchesBranchProtectionTemplate(config *GhRepoConfig, templates *TemplatesConfig) error {
 	if config.Branches != nil {
 		for branchName, branchConfig := range *config.Branches {
@@ -345,13 +981,16 @@ func applyBranchesBranchProtectionTemplate(config *GhRepoConfig, templates *Temp
 
 			var err error
 
+			// Forbid may only come from templates
 			wrapper := &GhBranchProtectionConfig{
 				Pattern:                      &branchName,
-				Forbid:                       &falseString,
+				Forbid:                       nil,
 				BaseGhBranchProtectionConfig: *branchConfig.Protection,
 			}
 			if wrapper, err = ApplyBranchProtectionTemplate(wrapper, templates); err != nil {
 				return BranchError(branchName, err)
+			} else if isForbiddenBranchProtection(wrapper) {
+				return BranchError(branchName, ErrForbiddenBranchProtection)
 			}
 
 			branchConfig.Protection = &wrapper.BaseGhBranchProtectionConfig
@@ -367,13 +1006,16 @@ func applyDefaultBranchProtectionTemplate(config *GhRepoConfig, templates *Templ
 
 		var err error
 
+		// Forbid may only come from templates
 		wrapper := &GhBranchProtectionConfig{
 			Pattern:                      &emptyVal,
-			Forbid:                       &falseString,
+			Forbid:                       nil,
 			BaseGhBranchProtectionConfig: *config.DefaultBranch.Protection,
 		}
 		if wrapper, err = ApplyBranchProtectionTemplate(wrapper, templates); err != nil {
 			return DefaultBranchError(err)
+		} else if isForbiddenBranchProtection(wrapper) {
+			return DefaultBranchError(ErrForbiddenBranchProtection)
 		}
 
 		config.DefaultBranch.Protection = &wrapper.BaseGhBranchProtectionConfig
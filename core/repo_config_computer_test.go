@@ -329,6 +329,67 @@ func TestComputeRepoConfig(t *testing.T) {
 	}
 }
 
+func TestComputeRepoConfig_deployKeyFiles(t *testing.T) {
+	t.Parallel()
+
+	repoName := "a_name"
+	title := "a-title"
+	keyFile := "testdata/deploy-keys/deploy-key1.pub"
+	unknownKeyFile := "testdata/deploy-keys/unknown.pub"
+	invalidKey := "ssh-ed25519 not-a-key"
+	key := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMkep8EAvhF//MDLWxu14eveA+xy2SbuAusenR2X7Kfb deploy-key1@example.com"
+	cases := map[string]struct {
+		value    *core.GhRepoConfig
+		expected *core.GhRepoConfig
+		error    error
+	}{
+		"key file": {
+			&core.GhRepoConfig{
+				Name:       &repoName,
+				DeployKeys: &core.GhRepoDeployKeysConfig{{Title: &title, KeyFile: &keyFile}},
+			},
+			&core.GhRepoConfig{
+				Name:       &repoName,
+				DeployKeys: &core.GhRepoDeployKeysConfig{{Title: &title, KeyFile: &keyFile, Key: &key}},
+			},
+			nil,
+		},
+		"unknown key file": {
+			&core.GhRepoConfig{
+				Name:       &repoName,
+				DeployKeys: &core.GhRepoDeployKeysConfig{{Title: &title, KeyFile: &unknownKeyFile}},
+			},
+			nil,
+			errors.New("deploy key #0: deploy key file not found: testdata/deploy-keys/unknown.pub"),
+		},
+		"invalid key": {
+			&core.GhRepoConfig{
+				Name:       &repoName,
+				DeployKeys: &core.GhRepoDeployKeysConfig{{Title: &title, Key: &invalidKey}},
+			},
+			nil,
+			errors.New("deploy key #0: invalid OpenSSH public key"),
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				actual, err := core.ComputeRepoConfig(tc.value, nil)
+				EnsureConfigMatching(t, tc.expected, actual, tc.error, err)
+
+				// Loaded key must only be set on computed config
+				if (*tc.value.DeployKeys)[0].KeyFile != nil && (*tc.value.DeployKeys)[0].Key != nil {
+					t.Errorf("Case %q: provided config updated", tcname)
+				}
+			},
+		)
+	}
+}
+
 func TestComputeRepoConfig_edgeCases(t *testing.T) {
 	t.Parallel()
 
@@ -336,7 +397,8 @@ func TestComputeRepoConfig_edgeCases(t *testing.T) {
 	pattern := "my_pattern"
 	allowDeletions := "true"
 	archived := "true"
-	forbid := "true"
+	// Not forbidden, as a forbidden branch can't allow deletion
+	forbid := "false"
 	allowForcePushes := "true"
 	enforceAdmins := "false"
 	sourceBranch := "a-source-branch"
@@ -698,6 +760,15 @@ func TestComputeRepoConfig_unknownTemplate(t *testing.T) {
 func TestComputeRepoConfig_validationError(t *testing.T) {
 	t.Parallel()
 
+	repoName := "a_name"
+	pattern := "a-pattern"
+	pattern2 := "another-pattern"
+	trueString := "true"
+	webhookName, webhookName2 := "a hook", "a-hook"
+	webhookUrl, webhookUrl2 := "https://a.example.com", "https://b.example.com"
+	forbiddenTplConfig := &core.TemplatesConfig{
+		BranchProtections: map[string]*core.GhBranchProtectionConfig{"forbidden": {Forbid: &trueString}},
+	}
 	cases := map[string]struct {
 		value     *core.GhRepoConfig
 		templates *core.TemplatesConfig
@@ -716,6 +787,105 @@ func TestComputeRepoConfig_validationError(t *testing.T) {
 			nil,
 			errors.New("repository name is mandatory"),
 		},
+		"forbidden branch protection allowing deletion": {
+			&core.GhRepoConfig{
+				Name: &repoName,
+				BranchProtections: &core.GhBranchProtectionsConfig{
+					{Pattern: &pattern, Forbid: &trueString},
+					{
+						Pattern:                      &pattern2,
+						Forbid:                       &trueString,
+						BaseGhBranchProtectionConfig: core.BaseGhBranchProtectionConfig{AllowDeletion: &trueString},
+					},
+				},
+			},
+			nil,
+			nil,
+			errors.New("branch protection #1: forbidden branch conflicts with 'deletion'"),
+		},
+		"forbidden branch protection allowing force push from template": {
+			&core.GhRepoConfig{
+				Name: &repoName,
+				BranchProtections: &core.GhBranchProtectionsConfig{
+					{
+						Pattern: &pattern,
+						Forbid:  &trueString,
+						BaseGhBranchProtectionConfig: core.BaseGhBranchProtectionConfig{
+							ConfigTemplates: &[]string{"force-push"},
+						},
+					},
+				},
+			},
+			&core.TemplatesConfig{
+				BranchProtections: map[string]*core.GhBranchProtectionConfig{
+					"force-push": {
+						BaseGhBranchProtectionConfig: core.BaseGhBranchProtectionConfig{
+							Pushes: &core.GhBranchProtectPushesConfig{AllowsForcePushes: &trueString},
+						},
+					},
+				},
+			},
+			nil,
+			errors.New("branch protection #0: forbidden branch conflicts with 'pushes.force-push'"),
+		},
+		"forbidden branch protection with push restrictions": {
+			&core.GhRepoConfig{
+				Name: &repoName,
+				BranchProtections: &core.GhBranchProtectionsConfig{
+					{
+						Pattern: &pattern,
+						Forbid:  &trueString,
+						BaseGhBranchProtectionConfig: core.BaseGhBranchProtectionConfig{
+							Pushes: &core.GhBranchProtectPushesConfig{RestrictTo: &[]string{"a-team"}},
+						},
+					},
+				},
+			},
+			nil,
+			nil,
+			errors.New("branch protection #0: forbidden branch conflicts with 'pushes.restrict-to'"),
+		},
+		"webhooks with same identifier": {
+			&core.GhRepoConfig{
+				Name: &repoName,
+				Webhooks: &core.GhWebhooksConfig{
+					{Name: &webhookName, Url: &webhookUrl},
+					{Name: &webhookName2, Url: &webhookUrl2},
+				},
+			},
+			nil,
+			nil,
+			errors.New("webhook #1: webhook identifier already used by another webhook"),
+		},
+		"forbidden default branch protection from template": {
+			&core.GhRepoConfig{
+				Name: &repoName,
+				DefaultBranch: &core.GhDefaultBranchConfig{
+					Name: &pattern,
+					BaseGhBranchConfig: core.BaseGhBranchConfig{
+						Protection: &core.BaseGhBranchProtectionConfig{ConfigTemplates: &[]string{"forbidden"}},
+					},
+				},
+			},
+			forbiddenTplConfig,
+			nil,
+			errors.New("default branch: forbid is only available for branch-protections"),
+		},
+		"forbidden branch protection from template": {
+			&core.GhRepoConfig{
+				Name: &repoName,
+				Branches: &core.GhBranchesConfig{
+					pattern: {
+						BaseGhBranchConfig: core.BaseGhBranchConfig{
+							Protection: &core.BaseGhBranchProtectionConfig{ConfigTemplates: &[]string{"forbidden"}},
+						},
+					},
+				},
+			},
+			forbiddenTplConfig,
+			nil,
+			errors.New("branch a-pattern: forbid is only available for branch-protections"),
+		},
 	}
 
 	for tcname, tc := range cases {
@@ -1249,3 +1419,229 @@ func TestApplyBranchesTemplate(t *testing.T) {
 		)
 	}
 }
+
+func TestApplyWebhooksTemplate(t *testing.T) {
+	t.Parallel()
+
+	aName := "a_name"
+	aTemplate := "a-template"
+	url := "https://ci.example.com/hook"
+	contentType := "json"
+	secret := "a-secret"
+	active := "false"
+	emptyTplConfig := &core.TemplatesConfig{}
+	tplConfig := &core.TemplatesConfig{
+		Webhooks: map[string]*core.GhWebhookConfig{
+			aTemplate: {Url: &url, ContentType: &contentType, Events: &[]string{"push"}},
+		},
+	}
+	cases := map[string]struct {
+		value     *core.GhRepoConfig
+		templates *core.TemplatesConfig
+		expected  *core.GhRepoConfig
+		error     error
+	}{
+		"nil": {
+			nil,
+			nil,
+			nil,
+			nil,
+		},
+		"no template available": {
+			&core.GhRepoConfig{
+				Webhooks: &core.GhWebhooksConfig{{ConfigTemplates: &[]string{aTemplate}}},
+			},
+			nil,
+			nil,
+			errors.New("webhook #0: webhook template not found as none available"),
+		},
+		"unknown template": {
+			&core.GhRepoConfig{
+				Webhooks: &core.GhWebhooksConfig{{ConfigTemplates: &[]string{aTemplate}}},
+			},
+			emptyTplConfig,
+			nil,
+			errors.New("webhook #0: \"a-template\" webhook template not found"),
+		},
+		"no url": {
+			&core.GhRepoConfig{
+				Webhooks: &core.GhWebhooksConfig{{Url: &url}, {Secret: &secret}},
+			},
+			emptyTplConfig,
+			nil,
+			errors.New("webhook #1: webhook url is mandatory"),
+		},
+		"base": {
+			&core.GhRepoConfig{
+				Webhooks: &core.GhWebhooksConfig{
+					{ConfigTemplates: &[]string{aTemplate}, Events: &[]string{"release"}, Secret: &secret},
+				},
+			},
+			tplConfig,
+			&core.GhRepoConfig{
+				Webhooks: &core.GhWebhooksConfig{
+					{Url: &url, ContentType: &contentType, Events: &[]string{"push", "release"}, Secret: &secret},
+				},
+			},
+			nil,
+		},
+		"duplicated url": {
+			&core.GhRepoConfig{
+				Name: &aName,
+				Webhooks: &core.GhWebhooksConfig{
+					{ConfigTemplates: &[]string{aTemplate}, Secret: &secret},
+					{Url: &url, Active: &active},
+				},
+			},
+			tplConfig,
+			&core.GhRepoConfig{
+				Name: &aName,
+				Webhooks: &core.GhWebhooksConfig{
+					{Url: &url, ContentType: &contentType, Events: &[]string{"push"}, Active: &active, Secret: &secret},
+				},
+			},
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				err := core.ApplyWebhooksTemplate(tc.value, tc.templates)
+				if tc.error != nil {
+					if err == nil {
+						t.Errorf("Case %q: expected an error but everything went well", tcname)
+					} else if err.Error() != tc.error.Error() {
+						t.Errorf("Case %q:\n- expected\n+ actual\n\n%v", tcname, differ.LineDiff(tc.error.Error(), err.Error()))
+					}
+				} else if err != nil {
+					t.Errorf("Case %q: %s", tcname, err)
+				} else if diff := cmp.Diff(tc.expected, tc.value); diff != "" {
+					t.Errorf("Config mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
+
+func TestApplyLabelsTemplate(t *testing.T) {
+	t.Parallel()
+
+	aTemplate := "a-template"
+	aTemplate2 := "a-template2"
+	bug := "bug"
+	upperBug := "Bug"
+	feature := "feature"
+	red := "ff0000"
+	green := "00ff00"
+	description := "a description"
+	authoritative := "true"
+	emptyTplConfig := &core.TemplatesConfig{}
+	tplConfig := &core.TemplatesConfig{
+		Labels: map[string]*core.GhLabelsConfig{
+			aTemplate: {Items: &core.GhLabelsItemsConfig{{&bug, &red, &description}}},
+			aTemplate2: {
+				Authoritative: &authoritative,
+				Items:         &core.GhLabelsItemsConfig{{&feature, &green, nil}},
+			},
+		},
+	}
+	cases := map[string]struct {
+		value     *core.GhRepoConfig
+		templates *core.TemplatesConfig
+		expected  *core.GhRepoConfig
+		error     error
+	}{
+		"nil": {
+			nil,
+			nil,
+			nil,
+			nil,
+		},
+		"no template available": {
+			&core.GhRepoConfig{Labels: &core.GhLabelsConfig{ConfigTemplates: &[]string{aTemplate}}},
+			nil,
+			nil,
+			errors.New("labels: labels template not found as none available"),
+		},
+		"unknown template": {
+			&core.GhRepoConfig{Labels: &core.GhLabelsConfig{ConfigTemplates: &[]string{aTemplate}}},
+			emptyTplConfig,
+			nil,
+			errors.New("labels: \"a-template\" labels template not found"),
+		},
+		"combined and overridden templates": {
+			&core.GhRepoConfig{
+				Labels: &core.GhLabelsConfig{
+					ConfigTemplates: &[]string{aTemplate, aTemplate2},
+					Items:           &core.GhLabelsItemsConfig{{&bug, &green, nil}},
+				},
+			},
+			tplConfig,
+			&core.GhRepoConfig{
+				Labels: &core.GhLabelsConfig{
+					Authoritative: &authoritative,
+					Items:         &core.GhLabelsItemsConfig{{&bug, &green, &description}, {&feature, &green, nil}},
+				},
+			},
+			nil,
+		},
+		"case insensitive template override": {
+			&core.GhRepoConfig{
+				Labels: &core.GhLabelsConfig{
+					ConfigTemplates: &[]string{aTemplate},
+					Items:           &core.GhLabelsItemsConfig{{&upperBug, &green, nil}},
+				},
+			},
+			tplConfig,
+			&core.GhRepoConfig{
+				Labels: &core.GhLabelsConfig{Items: &core.GhLabelsItemsConfig{{&upperBug, &green, &description}}},
+			},
+			nil,
+		},
+		"case insensitive duplicated name": {
+			&core.GhRepoConfig{
+				Labels: &core.GhLabelsConfig{
+					ConfigTemplates: &[]string{aTemplate},
+					Items:           &core.GhLabelsItemsConfig{{&bug, &green, nil}, {&upperBug, &green, nil}},
+				},
+			},
+			tplConfig,
+			nil,
+			errors.New("labels: label Bug: label already declared (names are case insensitive)"),
+		},
+		"no color": {
+			&core.GhRepoConfig{
+				Labels: &core.GhLabelsConfig{Items: &core.GhLabelsItemsConfig{{&feature, nil, &description}}},
+			},
+			emptyTplConfig,
+			nil,
+			errors.New("labels: label feature: label color is mandatory"),
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				err := core.ApplyLabelsTemplate(tc.value, tc.templates)
+				if tc.error != nil {
+					if err == nil {
+						t.Errorf("Case %q: expected an error but everything went well", tcname)
+					} else if err.Error() != tc.error.Error() {
+						t.Errorf("Case %q:\n- expected\n+ actual\n\n%v", tcname, differ.LineDiff(tc.error.Error(), err.Error()))
+					}
+				} else if err != nil {
+					t.Errorf("Case %q: %s", tcname, err)
+				} else if diff := cmp.Diff(tc.expected, tc.value); diff != "" {
+					t.Errorf("Config mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
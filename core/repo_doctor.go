@@ -0,0 +1,215 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yoanm/go-gh2tf"
+	"github.com/yoanm/go-tfsig"
+)
+
+// appliedRepoFieldNames contains fields already applied on computed configs (templates, or files loaded during
+// computation), they can't reach terraform files.
+//
+//nolint:gochecknoglobals // Easier than duplicate it everywhere needed
+var appliedRepoFieldNames = []string{"_templates", "key-file"}
+
+// repoField is a leaf of a repository config (a string or a list of strings), which can be temporarily updated.
+type repoField struct {
+	path    string
+	update  func()
+	restore func()
+}
+
+/** Public **/
+
+// FindIgnoredRepoFields returns the path (e.g. `misc.pages.domain` or `branch-protections[0].deletion`) of each field
+// provided in the repository config which doesn't affect generated terraform files.
+//
+// Fields are updated one after the other and terraform files are generated again, a field is considered as ignored if
+// generated files remain the same. Only a change of generated files is checked, not that the updated value itself
+// reaches them (e.g. a field only toggling another block is considered as used).
+//
+// Provided config is restored afterward, but must not be used concurrently. Generation warnings are logged for each
+// update, callers may want to disable them.
+func FindIgnoredRepoFields(repoConfig *GhRepoConfig, ctx *WorkspaceContext) []string {
+	if repoConfig == nil || repoConfig.Name == nil {
+		return nil
+	}
+
+	valGen := gh2tf.NewValueGenerator()
+	repoTfId := tfsig.ToTerraformIdentifier(*repoConfig.Name)
+
+	expected, ok := renderRepositoryFiles(repoConfig, valGen, repoTfId, ctx)
+	if !ok {
+		return nil
+	}
+
+	ignoredList := []string{}
+
+	for _, field := range collectRepoFields("", reflect.ValueOf(repoConfig)) {
+		field.update()
+		actual, ok := renderRepositoryFiles(repoConfig, valGen, repoTfId, ctx)
+		field.restore()
+
+		// A field making generation fail is obviously used
+		if ok && actual == expected {
+			ignoredList = append(ignoredList, field.path)
+		}
+	}
+
+	return ignoredList
+}
+
+/** Private **/
+
+func renderRepositoryFiles(
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	ctx *WorkspaceContext,
+) (content string, ok bool) {
+	defer func() {
+		if recover() != nil {
+			content, ok = "", false
+		}
+	}()
+
+	content = string(NewHclRepositoryWithContext(repoTfId, repoConfig, valGen, ctx).Bytes())
+	if variablesFile := NewHclVariables([]*GhRepoConfig{repoConfig}, valGen); variablesFile != nil {
+		content += string(variablesFile.Bytes())
+	}
+
+	return content, true
+}
+
+// collectRepoFields returns provided fields, in declaration order. Nil fields and applied fields are skipped.
+//
+//nolint:exhaustive // Other kinds are not used by configs
+func collectRepoFields(path string, value reflect.Value) []repoField {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return nil
+		}
+
+		return collectRepoFields(path, value.Elem())
+	case reflect.Struct:
+		return collectStructFields(path, value)
+	case reflect.Map:
+		return collectMapFields(path, value)
+	case reflect.Slice:
+		if value.Type().Elem().Kind() != reflect.String {
+			fieldList := []repoField{}
+			for idx := range value.Len() {
+				fieldList = append(fieldList, collectRepoFields(fmt.Sprintf("%s[%d]", path, idx), value.Index(idx))...)
+			}
+
+			return fieldList
+		}
+
+		return []repoField{newRepoField(path, value, updatedList(value))}
+	case reflect.String:
+		return []repoField{newRepoField(path, value, reflect.ValueOf(updatedValue(value.String())).Convert(value.Type()))}
+	}
+
+	return nil
+}
+
+func collectStructFields(path string, value reflect.Value) []repoField {
+	fieldList := []repoField{}
+
+	for idx := range value.NumField() {
+		structField := value.Type().Field(idx)
+		name, _, _ := strings.Cut(structField.Tag.Get("yaml"), ",")
+
+		switch {
+		case name == "-" || slices.Contains(appliedRepoFieldNames, name):
+			continue
+		case structField.Anonymous:
+			// Inlined struct
+			fieldList = append(fieldList, collectRepoFields(path, value.Field(idx))...)
+		case path == "":
+			fieldList = append(fieldList, collectRepoFields(name, value.Field(idx))...)
+		default:
+			fieldList = append(fieldList, collectRepoFields(path+"."+name, value.Field(idx))...)
+		}
+	}
+
+	return fieldList
+}
+
+func collectMapFields(path string, value reflect.Value) []repoField {
+	keys := make(map[string]reflect.Value, value.Len())
+	for _, key := range value.MapKeys() {
+		keys[key.String()] = key
+	}
+
+	fieldList := []repoField{}
+	sortedKeys, _ := MapToSortedListWithKeys(keys)
+
+	for _, key := range sortedKeys {
+		itemPath := fmt.Sprintf("%s[%s]", path, key)
+		item := value.MapIndex(keys[key])
+
+		if item.Kind() != reflect.String {
+			fieldList = append(fieldList, collectRepoFields(itemPath, item)...)
+
+			continue
+		}
+
+		// Map items are not addressable, map itself must be updated
+		mapKey := keys[key]
+		newItem := reflect.ValueOf(updatedValue(item.String())).Convert(item.Type())
+		fieldList = append(fieldList, repoField{
+			path:    itemPath,
+			update:  func() { value.SetMapIndex(mapKey, newItem) },
+			restore: func() { value.SetMapIndex(mapKey, item) },
+		})
+	}
+
+	return fieldList
+}
+
+func newRepoField(path string, value reflect.Value, newValue reflect.Value) repoField {
+	oldValue := reflect.New(value.Type()).Elem()
+	oldValue.Set(value)
+
+	return repoField{
+		path:    path,
+		update:  func() { value.Set(newValue) },
+		restore: func() { value.Set(oldValue) },
+	}
+}
+
+// updatedList returns a copy of the list with an additional item.
+func updatedList(list reflect.Value) reflect.Value {
+	newItem := "updated"
+	if list.Len() > 0 {
+		newItem = updatedValue(list.Index(list.Len() - 1).String())
+	}
+
+	newList := reflect.MakeSlice(list.Type(), 0, list.Len()+1)
+	newList = reflect.AppendSlice(newList, list)
+
+	return reflect.Append(newList, reflect.ValueOf(newItem).Convert(list.Type().Elem()))
+}
+
+// updatedValue returns a different value of the same kind (boolean, number or string).
+func updatedValue(value string) string {
+	switch value {
+	case "true":
+		return "false"
+	case "false":
+		return "true"
+	}
+
+	if number, err := strconv.Atoi(value); err == nil {
+		return strconv.Itoa(number + 1)
+	}
+
+	return value + "-updated"
+}
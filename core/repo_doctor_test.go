@@ -0,0 +1,170 @@
+package core_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+//nolint:paralleltest // Can't be done on parallel as FindIgnoredRepoFields must not be used concurrently
+func TestFindIgnoredRepoFields(t *testing.T) {
+	repoName := "a_repo"
+	trueString := "true"
+	templateSource := "an-owner/a-template"
+	newCtx := func() *core.WorkspaceContext {
+		config := core.NewConfig()
+		config.AppendTeam(GetFullTeamConfig(1))
+		config.AppendTeam(GetFullTeamConfig(2))
+
+		return core.NewWorkspaceContext(config)
+	}
+	cases := map[string]struct {
+		value    *core.GhRepoConfig
+		expected []string
+	}{
+		"nil": {
+			nil,
+			nil,
+		},
+		"Full 1": {
+			GetFullConfig(1),
+			[]string{},
+		},
+		"Full 2": {
+			GetFullConfig(2),
+			[]string{},
+		},
+		"Full clone without template source": {
+			&core.GhRepoConfig{
+				Name: &repoName,
+				Miscellaneous: &core.GhRepoMiscellaneousConfig{
+					Template: &core.GhRepoTemplateConfig{FullClone: &trueString},
+				},
+			},
+			[]string{"misc.template.full-clone"},
+		},
+		"Full clone with template source": {
+			&core.GhRepoConfig{
+				Name: &repoName,
+				Miscellaneous: &core.GhRepoMiscellaneousConfig{
+					Template: &core.GhRepoTemplateConfig{Source: &templateSource, FullClone: &trueString},
+				},
+			},
+			[]string{},
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				var original *core.GhRepoConfig
+				if tc.value != nil {
+					//nolint:exhaustruct // No need here, it's base structure
+					original = &core.GhRepoConfig{}
+					original.Merge(tc.value)
+				}
+
+				actual := core.FindIgnoredRepoFields(tc.value, newCtx())
+				if diff := cmp.Diff(tc.expected, actual); diff != "" {
+					t.Errorf("Case %q: ignored fields mismatch (-want +got):\n%s", tcname, diff)
+				}
+
+				if diff := cmp.Diff(original, tc.value); diff != "" {
+					t.Errorf("Case %q: config has not been restored (-want +got):\n%s", tcname, diff)
+				}
+			},
+		)
+	}
+}
+
+// TestFindIgnoredRepoFields_coverage ensures that full configs used above provide every repository config field,
+// so every field is proven to reach terraform files.
+func TestFindIgnoredRepoFields_coverage(t *testing.T) {
+	t.Parallel()
+
+	providedList := map[string]bool{}
+
+	for _, id := range []int{1, 2} {
+		collectProvidedFieldPaths("", reflect.ValueOf(GetFullConfig(id)), providedList)
+	}
+
+	for _, path := range collectFieldPaths("", reflect.TypeOf(core.GhRepoConfig{})) {
+		// Templates are applied before terraform generation
+		if !providedList[path] && !strings.HasSuffix(path, "_templates") {
+			t.Errorf("field %q is not provided by full configs", path)
+		}
+	}
+}
+
+// collectFieldPaths returns every leaf field path of the type, with "[]" for list and map items.
+func collectFieldPaths(path string, fieldType reflect.Type) []string {
+	//nolint:exhaustive // Other kinds are not used by configs
+	switch fieldType.Kind() {
+	case reflect.Pointer:
+		return collectFieldPaths(path, fieldType.Elem())
+	case reflect.Struct:
+		pathList := []string{}
+
+		for idx := range fieldType.NumField() {
+			field := fieldType.Field(idx)
+			name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+
+			if field.Anonymous {
+				pathList = append(pathList, collectFieldPaths(path, field.Type)...)
+			} else {
+				pathList = append(pathList, collectFieldPaths(strings.TrimPrefix(path+"."+name, "."), field.Type)...)
+			}
+		}
+
+		return pathList
+	case reflect.Map, reflect.Slice:
+		if fieldType.Elem().Kind() != reflect.String || fieldType.Kind() == reflect.Map {
+			return collectFieldPaths(path+"[]", fieldType.Elem())
+		}
+	}
+
+	return []string{path}
+}
+
+// collectProvidedFieldPaths marks leaf field paths provided by the value, with "[]" for list and map items.
+func collectProvidedFieldPaths(path string, value reflect.Value, providedList map[string]bool) {
+	//nolint:exhaustive // Other kinds are not used by configs
+	switch value.Kind() {
+	case reflect.Pointer:
+		if !value.IsNil() {
+			collectProvidedFieldPaths(path, value.Elem(), providedList)
+		}
+	case reflect.Struct:
+		for idx := range value.NumField() {
+			field := value.Type().Field(idx)
+			name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+
+			if field.Anonymous {
+				collectProvidedFieldPaths(path, value.Field(idx), providedList)
+			} else {
+				collectProvidedFieldPaths(strings.TrimPrefix(path+"."+name, "."), value.Field(idx), providedList)
+			}
+		}
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			collectProvidedFieldPaths(path+"[]", value.MapIndex(key), providedList)
+		}
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.String {
+			providedList[path] = true
+
+			return
+		}
+
+		for idx := range value.Len() {
+			collectProvidedFieldPaths(path+"[]", value.Index(idx), providedList)
+		}
+	default:
+		providedList[path] = true
+	}
+}
@@ -0,0 +1,422 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+// TerraformResource describes a terraform resource generated for a configuration.
+type TerraformResource struct {
+	// Address is the terraform resource address (e.g. "github_repository.my-repo")
+	Address string
+	// ImportId is the ID expected by "terraform import" for the resource,
+	// empty if it can't be computed from the configuration
+	ImportId string
+}
+
+/** Public **/
+
+// GenerateResources returns the list of terraform resources generated for each repository and team configuration.
+func GenerateResources(config *Config) ([]*TerraformResource, error) {
+	list, err := GenerateRepoResources(config.Repos)
+	if err != nil {
+		return nil, err
+	}
+
+	var errList []error
+
+	for k, teamConfig := range config.Teams {
+		if teamConfig.Name == nil {
+			errList = append(errList, TeamNameIsMandatoryForTeamError(k))
+		} else {
+			list = append(list, NewTeamResources(tfsig.ToTerraformIdentifier(*teamConfig.Name), teamConfig)...)
+		}
+	}
+
+	if len(errList) > 0 {
+		return nil, ComputationError(errList)
+	}
+
+	return list, nil
+}
+
+// GenerateRepoResources returns the list of terraform resources generated for each repository configuration.
+func GenerateRepoResources(configList []*GhRepoConfig) ([]*TerraformResource, error) {
+	var errList []error
+
+	list := []*TerraformResource{}
+
+	for k, repoConfig := range configList {
+		if repoConfig.Name == nil {
+			errList = append(errList, RepositoryNameIsMandatoryForConfigIndexError(k))
+		} else {
+			list = append(list, NewRepositoryResources(tfsig.ToTerraformIdentifier(*repoConfig.Name), repoConfig)...)
+		}
+	}
+
+	if len(errList) > 0 {
+		return nil, ComputationError(errList)
+	}
+
+	return list, nil
+}
+
+// NewRepositoryResources returns the list of terraform resources generated by NewHclRepository
+// for the given repository configuration, in the same order.
+func NewRepositoryResources(repoTfId string, repoConfig *GhRepoConfig) []*TerraformResource {
+	if repoConfig == nil || repoConfig.Name == nil {
+		return nil
+	}
+
+	valGen := tfsig.NewValueGenerator()
+	repoName := *repoConfig.Name
+
+	list := []*TerraformResource{
+		{"github_repository." + MapToRepositoryRes(repoConfig, valGen, repoTfId).Identifier, repoName},
+	}
+
+	if res := MapToDefaultBranchRes(repoConfig.DefaultBranch, valGen, repoConfig, repoTfId); res != nil {
+		list = append(list, &TerraformResource{"github_branch_default." + res.Identifier, repoName})
+	}
+
+	list = append(list, newBranchResources(repoConfig, repoTfId, repoName)...)
+
+	list = append(list, newBranchProtectionResources(repoConfig, repoTfId, repoName)...)
+	list = append(list, newRulesetResources(repoConfig, repoTfId)...)
+	list = append(list, newEnvironmentResources(repoConfig, repoTfId, repoName)...)
+	list = append(list, newActionsResources(repoConfig, repoTfId, repoName)...)
+	list = append(list, newWebhookResources(repoConfig, repoTfId)...)
+	list = append(list, newLabelResources(repoConfig, repoTfId, repoName)...)
+	list = append(list, newRepositoryFileResources(repoConfig, repoTfId, repoName)...)
+	list = append(list, newSecurityResources(repoConfig, repoTfId, repoName)...)
+	list = append(list, newDeployKeyResources(repoConfig, repoTfId)...)
+	list = append(list, newAutolinkReferenceResources(repoConfig, repoTfId)...)
+	list = append(list, newCustomPropertyResources(repoConfig, repoTfId, repoName)...)
+	list = append(list, newTeamRepositoryResources(repoConfig, repoTfId, repoName)...)
+
+	return append(list, newRepositoryCollaboratorResources(repoConfig, repoTfId, repoName)...)
+}
+
+// NewTeamResources returns the list of terraform resources generated by NewHclTeam for the given team
+// configuration, in the same order.
+func NewTeamResources(teamTfId string, teamConfig *GhTeamConfig) []*TerraformResource {
+	if teamConfig == nil || teamConfig.Name == nil {
+		return nil
+	}
+
+	valGen := tfsig.NewValueGenerator()
+
+	list := []*TerraformResource{
+		{"github_team." + MapToTeamRes(teamConfig, valGen, teamTfId, nil).Identifier, *teamConfig.Name},
+	}
+
+	if sig := NewTeamMembersSignature(MapToTeamMembersRes(teamConfig, valGen, teamTfId)); sig != nil {
+		list = append(list, &TerraformResource{"github_team_members." + teamTfId, TeamSlug(*teamConfig.Name)})
+	}
+
+	return list
+}
+
+/** Private **/
+
+func newBranchResources(repoConfig *GhRepoConfig, repoTfId string, repoName string) []*TerraformResource {
+	if repoConfig.Branches == nil {
+		return nil
+	}
+
+	valGen := tfsig.NewValueGenerator()
+	list := []*TerraformResource{}
+	keys, branches := MapToSortedListWithKeys(*repoConfig.Branches)
+
+	for idx, branchConfig := range branches {
+		if res := MapToBranchRes(keys[idx], branchConfig, valGen, repoConfig, repoTfId); res != nil {
+			importId := fmt.Sprintf("%s:%s", repoName, keys[idx])
+			if res.SourceBranch != nil {
+				// Source branch must be provided, else "main" is used during the import
+				importId = fmt.Sprintf("%s:%s", importId, *res.SourceBranch)
+			}
+
+			list = append(list, &TerraformResource{"github_branch." + res.Identifier, importId})
+		}
+	}
+
+	return list
+}
+
+func newBranchProtectionResources(repoConfig *GhRepoConfig, repoTfId string, repoName string) []*TerraformResource {
+	valGen := tfsig.NewValueGenerator()
+	list := []*TerraformResource{}
+
+	if res := MapDefaultBranchToBranchProtectionRes(
+		repoConfig.DefaultBranch,
+		valGen,
+		repoConfig,
+		repoTfId,
+	); res != nil {
+		list = append(list, newBranchProtectionResource(res.Identifier, repoName, res.Pattern))
+	}
+
+	if repoConfig.Branches != nil {
+		keys, branches := MapToSortedListWithKeys(*repoConfig.Branches)
+
+		for idx, branchConfig := range branches {
+			key := keys[idx]
+
+			if res := MapBranchToBranchProtectionRes(
+				&key,
+				branchConfig.Protection,
+				valGen,
+				repoConfig,
+				repoTfId,
+			); res != nil {
+				list = append(list, newBranchProtectionResource(res.Identifier, repoName, res.Pattern))
+			}
+		}
+	}
+
+	if repoConfig.BranchProtections != nil {
+		for _, branchProtectionConfig := range *repoConfig.BranchProtections {
+			if res := MapToBranchProtectionRes(branchProtectionConfig, valGen, repoConfig, repoTfId); res != nil {
+				list = append(list, newBranchProtectionResource(res.Identifier, repoName, res.Pattern))
+			}
+		}
+	}
+
+	return list
+}
+
+func newBranchProtectionResource(identifier string, repoName string, pattern *string) *TerraformResource {
+	importId := ""
+	if pattern != nil {
+		importId = fmt.Sprintf("%s:%s", repoName, *pattern)
+	}
+
+	return &TerraformResource{"github_branch_protection." + identifier, importId}
+}
+
+func newRulesetResources(repoConfig *GhRepoConfig, repoTfId string) []*TerraformResource {
+	list := []*TerraformResource{}
+
+	for _, res := range MapToRepositoryRulesetResList(repoConfig, tfsig.NewValueGenerator(), repoTfId, nil) {
+		// Import ID requires the ruleset ID, which is only known by GitHub
+		list = append(list, &TerraformResource{"github_repository_ruleset." + res.Identifier, ""})
+	}
+
+	return list
+}
+
+func newEnvironmentResources(repoConfig *GhRepoConfig, repoTfId string, repoName string) []*TerraformResource {
+	valGen := tfsig.NewValueGenerator()
+	list := []*TerraformResource{}
+
+	for _, res := range MapToRepositoryEnvironmentResList(repoConfig, valGen, repoTfId, nil) {
+		importId := fmt.Sprintf("%s:%s", repoName, *res.Environment)
+
+		list = append(list, &TerraformResource{"github_repository_environment." + res.Identifier, importId})
+	}
+
+	for _, res := range MapToRepositoryEnvironmentDeploymentPolicyResList(repoConfig, valGen, repoTfId) {
+		// Import ID requires the policy ID, which is only known by GitHub
+		list = append(
+			list,
+			&TerraformResource{"github_repository_environment_deployment_policy." + res.Identifier, ""},
+		)
+	}
+
+	return list
+}
+
+func newActionsResources(repoConfig *GhRepoConfig, repoTfId string, repoName string) []*TerraformResource {
+	valGen := tfsig.NewValueGenerator()
+	list := []*TerraformResource{}
+
+	permissionsRes := MapToActionsRepositoryPermissionsRes(repoConfig, valGen, repoTfId)
+	if sig := NewActionsRepositoryPermissionsSignature(permissionsRes); sig != nil {
+		list = append(
+			list,
+			&TerraformResource{"github_actions_repository_permissions." + permissionsRes.Identifier, repoName},
+		)
+	}
+
+	workflowRes := MapToWorkflowRepositoryPermissionsRes(repoConfig, valGen, repoTfId)
+	if sig := NewWorkflowRepositoryPermissionsSignature(workflowRes); sig != nil {
+		list = append(
+			list,
+			&TerraformResource{"github_workflow_repository_permissions." + workflowRes.Identifier, repoName},
+		)
+	}
+
+	for _, res := range MapToActionsVariableResList(repoConfig, valGen, repoTfId) {
+		importId := fmt.Sprintf("%s:%s", repoName, *res.VariableName)
+
+		list = append(list, &TerraformResource{"github_actions_variable." + res.Identifier, importId})
+	}
+
+	// Secret values are never returned by GitHub, secrets can't be imported
+	for _, res := range MapToActionsSecretResList(repoConfig, valGen, repoTfId) {
+		list = append(list, &TerraformResource{"github_actions_secret." + res.Identifier, ""})
+	}
+
+	if repoConfig.Environments != nil {
+		envKeys, environments := MapToSortedListWithKeys(*repoConfig.Environments)
+
+		for envIdx, envName := range envKeys {
+			actions := environments[envIdx].Actions
+			if actions == nil || actions.Variables == nil {
+				continue
+			}
+
+			envTfId := mapEnvironmentTfId(repoTfId, envName)
+			keys, _ := MapToSortedListWithKeys(*actions.Variables)
+
+			for _, name := range keys {
+				importId := fmt.Sprintf("%s:%s:%s", repoName, envName, name)
+
+				list = append(
+					list,
+					&TerraformResource{"github_actions_environment_variable." + mapActionsTfId(envTfId, name), importId},
+				)
+			}
+		}
+	}
+
+	for _, res := range MapToActionsEnvironmentSecretResList(repoConfig, valGen, repoTfId) {
+		list = append(list, &TerraformResource{"github_actions_environment_secret." + res.Identifier, ""})
+	}
+
+	return list
+}
+
+func newWebhookResources(repoConfig *GhRepoConfig, repoTfId string) []*TerraformResource {
+	list := []*TerraformResource{}
+
+	for _, res := range MapToWebhookResList(repoConfig, tfsig.NewValueGenerator(), repoTfId) {
+		// Import ID requires the webhook ID, which is only known by GitHub
+		list = append(list, &TerraformResource{"github_repository_webhook." + res.Identifier, ""})
+	}
+
+	return list
+}
+
+func newLabelResources(repoConfig *GhRepoConfig, repoTfId string, repoName string) []*TerraformResource {
+	valGen := tfsig.NewValueGenerator()
+	list := []*TerraformResource{}
+
+	if res := MapToIssueLabelsRes(repoConfig, valGen, repoTfId); res != nil {
+		list = append(list, &TerraformResource{"github_issue_labels." + res.Identifier, repoName})
+	}
+
+	for _, res := range MapToIssueLabelResList(repoConfig, valGen, repoTfId) {
+		importId := fmt.Sprintf("%s:%s", repoName, *res.Name)
+
+		list = append(list, &TerraformResource{"github_issue_label." + res.Identifier, importId})
+	}
+
+	return list
+}
+
+func newRepositoryFileResources(repoConfig *GhRepoConfig, repoTfId string, repoName string) []*TerraformResource {
+	list := []*TerraformResource{}
+
+	for _, res := range MapToRepositoryFileResList(repoConfig, tfsig.NewValueGenerator(), repoTfId) {
+		importId := fmt.Sprintf("%s/%s", repoName, *res.File)
+		if res.Branch != nil {
+			// Branch must be provided, else the default branch is used during the import
+			importId = fmt.Sprintf("%s:%s", importId, *res.Branch)
+		}
+
+		list = append(list, &TerraformResource{"github_repository_file." + res.Identifier, importId})
+	}
+
+	return list
+}
+
+func newDeployKeyResources(repoConfig *GhRepoConfig, repoTfId string) []*TerraformResource {
+	list := []*TerraformResource{}
+
+	for _, res := range MapToDeployKeyResList(repoConfig, tfsig.NewValueGenerator(), repoTfId) {
+		// Import ID requires the deploy key ID, which is only known by GitHub
+		list = append(list, &TerraformResource{"github_repository_deploy_key." + res.Identifier, ""})
+	}
+
+	return list
+}
+
+func newAutolinkReferenceResources(repoConfig *GhRepoConfig, repoTfId string) []*TerraformResource {
+	list := []*TerraformResource{}
+
+	for _, res := range MapToAutolinkReferenceResList(repoConfig, tfsig.NewValueGenerator(), repoTfId) {
+		// Import ID requires the autolink reference ID, which is only known by GitHub
+		list = append(list, &TerraformResource{"github_repository_autolink_reference." + res.Identifier, ""})
+	}
+
+	return list
+}
+
+func newCustomPropertyResources(repoConfig *GhRepoConfig, repoTfId string, repoName string) []*TerraformResource {
+	list := []*TerraformResource{}
+
+	// Property type has no impact on resource identifiers, no need for the workspace context
+	for _, res := range MapToCustomPropertyResList(repoConfig, tfsig.NewValueGenerator(), repoTfId, nil) {
+		list = append(
+			list,
+			&TerraformResource{"github_repository_custom_property." + res.Identifier, repoName + ":" + *res.PropertyName},
+		)
+	}
+
+	return list
+}
+
+func newSecurityResources(repoConfig *GhRepoConfig, repoTfId string, repoName string) []*TerraformResource {
+	valGen := tfsig.NewValueGenerator()
+	list := []*TerraformResource{}
+
+	if res := MapToDependabotSecurityUpdatesRes(repoConfig, valGen, repoTfId); res != nil {
+		list = append(
+			list,
+			&TerraformResource{"github_repository_dependabot_security_updates." + res.Identifier, repoName},
+		)
+	}
+
+	if res := MapToPrivateVulnerabilityReportingRes(repoConfig, valGen, repoTfId); res != nil {
+		// Nothing to import, GitHub CLI is used to manage private vulnerability reporting
+		list = append(list, &TerraformResource{"terraform_data." + res.Identifier, ""})
+	}
+
+	if res := MapToCodeScanningRes(repoConfig, valGen, repoTfId); res != nil {
+		// Nothing to import, GitHub CLI is used to manage code scanning default setup
+		list = append(list, &TerraformResource{"terraform_data." + res.Identifier, ""})
+	}
+
+	return list
+}
+
+func newTeamRepositoryResources(repoConfig *GhRepoConfig, repoTfId string, repoName string) []*TerraformResource {
+	list := []*TerraformResource{}
+
+	for _, res := range MapToTeamRepositoryResList(repoConfig, tfsig.NewValueGenerator(), repoTfId, nil) {
+		// Team is either a declared team name or the slug of an existing team
+		importId := fmt.Sprintf("%s:%s", TeamSlug(*res.TeamId), repoName)
+
+		list = append(list, &TerraformResource{"github_team_repository." + res.Identifier, importId})
+	}
+
+	return list
+}
+
+func newRepositoryCollaboratorResources(
+	repoConfig *GhRepoConfig,
+	repoTfId string,
+	repoName string,
+) []*TerraformResource {
+	list := []*TerraformResource{}
+
+	for _, res := range MapToRepositoryCollaboratorResList(repoConfig, tfsig.NewValueGenerator(), repoTfId) {
+		importId := fmt.Sprintf("%s:%s", repoName, *res.Username)
+
+		list = append(list, &TerraformResource{"github_repository_collaborator." + res.Identifier, importId})
+	}
+
+	return list
+}
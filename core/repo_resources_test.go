@@ -0,0 +1,152 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+func TestGenerateRepoResources(t *testing.T) {
+	t.Parallel()
+
+	repoName := "a.repo"
+	repo2Name := "repo2"
+	defaultBranchName := "master"
+	sourceBranchName := "develop"
+	pattern := "release/*"
+	cases := map[string]struct {
+		value    []*core.GhRepoConfig
+		expected []*core.TerraformResource
+		error    error
+	}{
+		"nil": {
+			nil,
+			[]*core.TerraformResource{},
+			nil,
+		},
+		"Minimal": {
+			[]*core.GhRepoConfig{{Name: &repoName}, {Name: &repo2Name}},
+			[]*core.TerraformResource{
+				{"github_repository.a-repo", "a.repo"},
+				{"github_repository.repo2", "repo2"},
+			},
+			nil,
+		},
+		"Full": {
+			[]*core.GhRepoConfig{
+				{
+					Name: &repoName,
+					DefaultBranch: &core.GhDefaultBranchConfig{
+						Name: &defaultBranchName,
+						BaseGhBranchConfig: core.BaseGhBranchConfig{
+							Protection: &core.BaseGhBranchProtectionConfig{},
+						},
+					},
+					Branches: &core.GhBranchesConfig{
+						"feature/b": &core.GhBranchConfig{
+							SourceBranch: &sourceBranchName,
+							BaseGhBranchConfig: core.BaseGhBranchConfig{
+								Protection: &core.BaseGhBranchProtectionConfig{},
+							},
+						},
+						sourceBranchName: &core.GhBranchConfig{},
+					},
+					BranchProtections: &core.GhBranchProtectionsConfig{{Pattern: &pattern}},
+					Teams:             &core.GhPermissionsConfig{"My Team": "push"},
+					Collaborators:     &core.GhPermissionsConfig{"a-user": "pull"},
+				},
+			},
+			[]*core.TerraformResource{
+				{"github_repository.a-repo", "a.repo"},
+				{"github_branch_default.a-repo", "a.repo"},
+				{"github_branch.a-repo-develop", "a.repo:develop"},
+				{"github_branch.a-repo-feature-b", "a.repo:feature/b:develop"},
+				{"github_branch_protection.a-repo-default", "a.repo:master"},
+				{"github_branch_protection.a-repo-feature-b", "a.repo:feature/b"},
+				{"github_branch_protection.a-repo-release--", "a.repo:release/*"},
+				{"github_team_repository.a-repo-My-Team", "my-team:a.repo"},
+				{"github_repository_collaborator.a-repo-a-user", "a.repo:a-user"},
+			},
+			nil,
+		},
+		"Repository without name": {
+			[]*core.GhRepoConfig{{Name: &repoName}, {}},
+			nil,
+			core.ComputationError([]error{core.RepositoryNameIsMandatoryForConfigIndexError(1)}),
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				actual, err := core.GenerateRepoResources(tc.value)
+				if tc.error != nil {
+					EnsureErrorMatching(t, tc.error, err)
+				} else if diff := cmp.Diff(tc.expected, actual); diff != "" {
+					t.Errorf("Resources mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
+
+func TestGenerateResources(t *testing.T) {
+	t.Parallel()
+
+	repoName := "a.repo"
+	teamName := "My Team"
+	member := "a-member"
+	cases := map[string]struct {
+		value    *core.Config
+		expected []*core.TerraformResource
+		error    error
+	}{
+		"empty": {
+			core.NewConfig(),
+			[]*core.TerraformResource{},
+			nil,
+		},
+		"Full": {
+			&core.Config{
+				Repos: []*core.GhRepoConfig{{Name: &repoName}},
+				Teams: []*core.GhTeamConfig{
+					{Name: &teamName, Members: &[]string{member}},
+					{Name: &member},
+				},
+			},
+			[]*core.TerraformResource{
+				{"github_repository.a-repo", "a.repo"},
+				{"github_team.My-Team", "My Team"},
+				{"github_team_members.My-Team", "my-team"},
+				{"github_team.a-member", "a-member"},
+			},
+			nil,
+		},
+		"Team without name": {
+			&core.Config{Teams: []*core.GhTeamConfig{{Name: &teamName}, {}}},
+			nil,
+			core.ComputationError([]error{core.TeamNameIsMandatoryForTeamError(1)}),
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				actual, err := core.GenerateResources(tc.value)
+				if tc.error != nil {
+					EnsureErrorMatching(t, tc.error, err)
+				} else if diff := cmp.Diff(tc.expected, actual); diff != "" {
+					t.Errorf("Resources mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
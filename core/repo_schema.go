@@ -17,6 +17,18 @@ type GhRepoConfig struct {
 	//nolint:tagliatelle // Long name, easier if it's just misc
 	Miscellaneous *GhRepoMiscellaneousConfig `yaml:"misc,omitempty"`
 	Terraform     *GhRepoTerraformConfig     `yaml:"terraform,omitempty"`
+	Teams         *GhPermissionsConfig       `yaml:"teams,omitempty"`
+	Collaborators *GhPermissionsConfig       `yaml:"collaborators,omitempty"`
+	Rulesets      *GhRulesetsConfig          `yaml:"rulesets,omitempty"`
+	Environments  *GhEnvironmentsConfig      `yaml:"environments,omitempty"`
+	Actions       *GhRepoActionsConfig       `yaml:"actions,omitempty"`
+	Webhooks      *GhWebhooksConfig          `yaml:"webhooks,omitempty"`
+	Labels        *GhLabelsConfig            `yaml:"labels,omitempty"`
+	Files         *GhRepoFilesConfig         `yaml:"files,omitempty"`
+	DeployKeys    *GhRepoDeployKeysConfig    `yaml:"deploy-keys,omitempty"`
+	Autolinks     *GhRepoAutolinksConfig     `yaml:"autolinks,omitempty"`
+	// CustomProperties values are validated against custom property definitions, see Config.CustomProperties
+	CustomProperties *GhRepoCustomPropertiesConfig `yaml:"custom-properties,omitempty"`
 }
 
 //nolint:gocognit,cyclop // Hard to factorize, more understandable as is
@@ -90,6 +102,109 @@ func (to *GhRepoConfig) Merge(from *GhRepoConfig) {
 
 		to.BranchProtections.Merge(from.BranchProtections)
 	}
+
+	if from.Teams != nil {
+		if to.Teams == nil {
+			to.Teams = &GhPermissionsConfig{}
+		}
+
+		to.Teams.Merge(from.Teams)
+	}
+
+	if from.Collaborators != nil {
+		if to.Collaborators == nil {
+			to.Collaborators = &GhPermissionsConfig{}
+		}
+
+		to.Collaborators.Merge(from.Collaborators)
+	}
+
+	if from.Rulesets != nil {
+		if to.Rulesets == nil {
+			to.Rulesets = &GhRulesetsConfig{}
+		}
+
+		to.Rulesets.Merge(from.Rulesets)
+	}
+
+	if from.Environments != nil {
+		if to.Environments == nil {
+			to.Environments = &GhEnvironmentsConfig{}
+		}
+
+		to.Environments.Merge(from.Environments)
+	}
+
+	if from.Actions != nil {
+		if to.Actions == nil {
+			//nolint:exhaustruct // No need here, simple init
+			to.Actions = &GhRepoActionsConfig{}
+		}
+
+		to.Actions.Merge(from.Actions)
+	}
+
+	if from.Webhooks != nil {
+		if to.Webhooks == nil {
+			to.Webhooks = &GhWebhooksConfig{}
+		}
+
+		to.Webhooks.Merge(from.Webhooks)
+	}
+
+	if from.Labels != nil {
+		if to.Labels == nil {
+			//nolint:exhaustruct // No need here, simple init
+			to.Labels = &GhLabelsConfig{}
+		}
+
+		to.Labels.Merge(from.Labels)
+	}
+
+	if from.Files != nil {
+		if to.Files == nil {
+			to.Files = &GhRepoFilesConfig{}
+		}
+
+		to.Files.Merge(from.Files)
+	}
+
+	if from.DeployKeys != nil {
+		if to.DeployKeys == nil {
+			to.DeployKeys = &GhRepoDeployKeysConfig{}
+		}
+
+		to.DeployKeys.Merge(from.DeployKeys)
+	}
+
+	if from.Autolinks != nil {
+		if to.Autolinks == nil {
+			to.Autolinks = &GhRepoAutolinksConfig{}
+		}
+
+		to.Autolinks.Merge(from.Autolinks)
+	}
+
+	if from.CustomProperties != nil {
+		if to.CustomProperties == nil {
+			to.CustomProperties = &GhRepoCustomPropertiesConfig{}
+		}
+
+		to.CustomProperties.Merge(from.CustomProperties)
+	}
+}
+
+// GhPermissionsConfig contains permissions (pull, triage, push, maintain or admin) by team or user name.
+type GhPermissionsConfig map[string]string
+
+func (to *GhPermissionsConfig) Merge(from *GhPermissionsConfig) {
+	if from == nil {
+		return
+	}
+
+	for name, permission := range *from {
+		(*to)[name] = permission
+	}
 }
 
 type GhBranchesConfig map[string]*GhBranchConfig
@@ -539,7 +654,15 @@ func (to *GhBranchProtectPRReviewDismissalsConfig) Merge(from *GhBranchProtectPR
 }
 
 type GhRepoSecurityConfig struct {
-	VulnerabilityAlerts *string `yaml:"vulnerability-alerts,omitempty"`
+	VulnerabilityAlerts          *string `yaml:"vulnerability-alerts,omitempty"`
+	AdvancedSecurity             *string `yaml:"advanced-security,omitempty"`
+	SecretScanning               *string `yaml:"secret-scanning,omitempty"`
+	SecretScanningPushProtection *string `yaml:"secret-scanning-push-protection,omitempty"`
+	DependabotSecurityUpdates    *string `yaml:"dependabot-security-updates,omitempty"`
+	// PrivateVulnerabilityReporting is not managed by the GitHub provider, GitHub CLI is used instead
+	PrivateVulnerabilityReporting *string `yaml:"private-vulnerability-reporting,omitempty"`
+	// CodeScanning is not managed by the GitHub provider, GitHub CLI is used instead
+	CodeScanning *GhRepoCodeScanningConfig `yaml:"code-scanning,omitempty"`
 }
 
 func (to *GhRepoSecurityConfig) Merge(from *GhRepoSecurityConfig) {
@@ -548,11 +671,46 @@ func (to *GhRepoSecurityConfig) Merge(from *GhRepoSecurityConfig) {
 	}
 
 	mergeStringIfNotNil(&to.VulnerabilityAlerts, from.VulnerabilityAlerts)
+	mergeStringIfNotNil(&to.AdvancedSecurity, from.AdvancedSecurity)
+	mergeStringIfNotNil(&to.SecretScanning, from.SecretScanning)
+	mergeStringIfNotNil(&to.SecretScanningPushProtection, from.SecretScanningPushProtection)
+	mergeStringIfNotNil(&to.DependabotSecurityUpdates, from.DependabotSecurityUpdates)
+	mergeStringIfNotNil(&to.PrivateVulnerabilityReporting, from.PrivateVulnerabilityReporting)
+
+	if from.CodeScanning != nil {
+		if to.CodeScanning == nil {
+			//nolint:exhaustruct // No need here, simple init
+			to.CodeScanning = &GhRepoCodeScanningConfig{}
+		}
+
+		to.CodeScanning.Merge(from.CodeScanning)
+	}
+}
+
+// GhRepoCodeScanningConfig contains CodeQL default setup configuration.
+type GhRepoCodeScanningConfig struct {
+	// State is either "configured" or "not-configured"
+	State *string `yaml:"state,omitempty"`
+	// QuerySuite is either "default" or "extended"
+	QuerySuite *string `yaml:"query-suite,omitempty"`
+	// Languages are all languages supported by the repository if not provided
+	Languages *[]string `yaml:"languages,omitempty,flow"`
+}
+
+func (to *GhRepoCodeScanningConfig) Merge(from *GhRepoCodeScanningConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeStringIfNotNil(&to.State, from.State)
+	mergeStringIfNotNil(&to.QuerySuite, from.QuerySuite)
+	mergeSliceIfNotNil(&to.Languages, from.Languages)
 }
 
 type GhRepoTerraformConfig struct {
-	ArchiveOnDestroy                    *string `yaml:"archive-on-destroy,omitempty"`
-	IgnoreVulnerabilityAlertsDuringRead *string `yaml:"ignore-vulnerability-alerts-during-read,omitempty"`
+	ArchiveOnDestroy                    *string   `yaml:"archive-on-destroy,omitempty"`
+	IgnoreVulnerabilityAlertsDuringRead *string   `yaml:"ignore-vulnerability-alerts-during-read,omitempty"`
+	PreviousNames                       *[]string `yaml:"previous-names,omitempty,flow"`
 }
 
 func (to *GhRepoTerraformConfig) Merge(from *GhRepoTerraformConfig) {
@@ -562,6 +720,7 @@ func (to *GhRepoTerraformConfig) Merge(from *GhRepoTerraformConfig) {
 
 	mergeStringIfNotNil(&to.ArchiveOnDestroy, from.ArchiveOnDestroy)
 	mergeStringIfNotNil(&to.IgnoreVulnerabilityAlertsDuringRead, from.IgnoreVulnerabilityAlertsDuringRead)
+	mergeSliceIfNotNil(&to.PreviousNames, from.PreviousNames)
 }
 
 // mergeStringIfNotNil ensures that updating 'from' afterward doesn't affect 'to' and vice versa
@@ -92,6 +92,17 @@ func TestGhRepoConfig_Merge_2(t *testing.T) {
 	toWithNilSlicesAndStruct.Security = nil
 	toWithNilSlicesAndStruct.Miscellaneous = nil
 	toWithNilSlicesAndStruct.Terraform = nil
+	toWithNilSlicesAndStruct.Teams = nil
+	toWithNilSlicesAndStruct.Collaborators = nil
+	toWithNilSlicesAndStruct.Rulesets = nil
+	toWithNilSlicesAndStruct.Environments = nil
+	toWithNilSlicesAndStruct.Actions = nil
+	toWithNilSlicesAndStruct.Webhooks = nil
+	toWithNilSlicesAndStruct.Labels = nil
+	toWithNilSlicesAndStruct.Files = nil
+	toWithNilSlicesAndStruct.DeployKeys = nil
+	toWithNilSlicesAndStruct.Autolinks = nil
+	toWithNilSlicesAndStruct.CustomProperties = nil
 	full1 := GetFullConfig(1)
 	full2 := GetFullConfig(2)
 	// manually generate result of full2 into full1
@@ -176,6 +187,54 @@ func TestGhRepoConfig_Merge_2(t *testing.T) {
 		*(full2.Miscellaneous.Topics)...,
 	)
 
+	*fullMergeResult.Security.CodeScanning.Languages = append(
+		*(full1.Security.CodeScanning.Languages),
+		*(full2.Security.CodeScanning.Languages)...,
+	)
+
+	*fullMergeResult.Terraform.PreviousNames = append(
+		*(full1.Terraform.PreviousNames),
+		*(full2.Terraform.PreviousNames)...,
+	)
+
+	(*fullMergeResult.Teams)["team1"] = (*full1.Teams)["team1"]
+	(*fullMergeResult.Collaborators)["user1"] = (*full1.Collaborators)["user1"]
+
+	*fullMergeResult.Rulesets = append(
+		*(full1.Rulesets),
+		*(full2.Rulesets)...,
+	)
+
+	(*fullMergeResult.Environments)["environment1"] = (*full1.Environments)["environment1"]
+	(*fullMergeResult.Environments)["protected-environment1"] = (*full1.Environments)["protected-environment1"]
+	(*fullMergeResult.Actions.Variables)["VARIABLE1"] = (*full1.Actions.Variables)["VARIABLE1"]
+	(*fullMergeResult.Actions.Secrets)["SECRET1"] = (*full1.Actions.Secrets)["SECRET1"]
+	*fullMergeResult.Actions.Permissions.Patterns = append(
+		*(full1.Actions.Permissions.Patterns),
+		*(full2.Actions.Permissions.Patterns)...,
+	)
+	*fullMergeResult.Webhooks = append(
+		*(full1.Webhooks),
+		*(full2.Webhooks)...,
+	)
+	*fullMergeResult.Labels.ConfigTemplates = append(
+		*(full1.Labels.ConfigTemplates),
+		*(full2.Labels.ConfigTemplates)...,
+	)
+	// Labels sharing the same name are merged
+	*fullMergeResult.Labels.Items = core.GhLabelsItemsConfig{
+		(*full1.Labels.Items)[0],
+		(*full2.Labels.Items)[1],
+		(*full2.Labels.Items)[0],
+	}
+	(*fullMergeResult.Files)["docs/file1.md"] = (*full1.Files)["docs/file1.md"]
+	*fullMergeResult.DeployKeys = append(
+		*(full1.DeployKeys),
+		*(full2.DeployKeys)...,
+	)
+	(*fullMergeResult.Autolinks)["TICKET1-"] = (*full1.Autolinks)["TICKET1-"]
+	(*fullMergeResult.CustomProperties)["property1"] = (*full1.CustomProperties)["property1"]
+
 	cases := map[string]struct {
 		value    *core.GhRepoConfig
 		from     *core.GhRepoConfig
@@ -1399,6 +1458,17 @@ func TestGhRepoPRBranchConfig_Merge_2(t *testing.T) {
 
 func updateGhRepoSecurityConfigHelper(c *core.GhRepoSecurityConfig, stringToCopy *string, newSliceToCopy *[]string, updatePtr bool) {
 	updateStringPtrHelper(&c.VulnerabilityAlerts, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.AdvancedSecurity, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.SecretScanning, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.SecretScanningPushProtection, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.DependabotSecurityUpdates, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.PrivateVulnerabilityReporting, stringToCopy, updatePtr)
+
+	if c.CodeScanning == nil {
+		c.CodeScanning = &core.GhRepoCodeScanningConfig{}
+	}
+
+	updateGhRepoCodeScanningConfigHelper(c.CodeScanning, stringToCopy, newSliceToCopy, updatePtr)
 }
 
 func TestGhRepoSecurityConfig_Merge(t *testing.T) {
@@ -1476,6 +1546,25 @@ func updateGhRepoMiscellaneousConfigHelper(c *core.GhRepoMiscellaneousConfig, st
 	updateGhRepoFileTemplatesConfigHelper(c.FileTemplates, stringToCopy, newSliceToCopy, updatePtr)
 }
 
+func updateGhRepoCodeScanningConfigHelper(c *core.GhRepoCodeScanningConfig, stringToCopy *string, newSliceToCopy *[]string, updatePtr bool) {
+	updateStringPtrHelper(&c.State, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.QuerySuite, stringToCopy, updatePtr)
+	updateSlicePtrHelper(&c.Languages, newSliceToCopy, updatePtr)
+}
+
+func TestGhRepoCodeScanningConfig_Merge(t *testing.T) {
+	t.Parallel()
+	// Ensure updating from afterward doesn't affect result of merge and vice versa
+	ensureMergeWithoutOverflowBetweenToAndFrom(
+		t,
+		core.GhRepoCodeScanningConfig{},
+		func(to, from *core.GhRepoCodeScanningConfig) {
+			to.Merge(from)
+		},
+		updateGhRepoCodeScanningConfigHelper,
+	)
+}
+
 func TestGhRepoMiscellaneousConfig_Merge(t *testing.T) {
 	t.Parallel()
 	// Ensure updating from afterward doesn't affect result of merge and vice versa
@@ -1686,6 +1775,7 @@ func TestGhRepoPagesConfig_Merge_2(t *testing.T) {
 func updateGhRepoTerraformConfigHelper(c *core.GhRepoTerraformConfig, stringToCopy *string, newSliceToCopy *[]string, updatePtr bool) {
 	updateStringPtrHelper(&c.ArchiveOnDestroy, stringToCopy, updatePtr)
 	updateStringPtrHelper(&c.IgnoreVulnerabilityAlertsDuringRead, stringToCopy, updatePtr)
+	updateSlicePtrHelper(&c.PreviousNames, newSliceToCopy, updatePtr)
 }
 
 func TestGhRepoTerraformConfig_Merge(t *testing.T) {
@@ -1891,3 +1981,43 @@ func updateSlicePtrHelper(to **[]string, newSliceToCopy *[]string, updatePtr boo
 		**to = newSlice
 	}
 }
+
+func TestGhPermissionsConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		value    *core.GhPermissionsConfig
+		from     *core.GhPermissionsConfig
+		expected *core.GhPermissionsConfig
+	}{
+		"full": {
+			GetFullConfig(1).Teams,
+			GetFullConfig(2).Teams,
+			&core.GhPermissionsConfig{"team1": "push", "team2": "triage", "shared-team": "admin"},
+		},
+		"to is empty": {
+			&core.GhPermissionsConfig{},
+			GetFullConfig(1).Teams,
+			GetFullConfig(1).Teams,
+		},
+		"from nil": {
+			GetFullConfig(1).Teams,
+			nil,
+			GetFullConfig(1).Teams,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+				tc.value.Merge(tc.from)
+
+				if diff := cmp.Diff(tc.expected, tc.value); diff != "" {
+					t.Errorf("Config mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
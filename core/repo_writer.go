@@ -5,20 +5,42 @@ import (
 
 	"github.com/yoanm/go-gh2tf/ghbranch"
 	"github.com/yoanm/go-gh2tf/ghbranchdefault"
-	"github.com/yoanm/go-gh2tf/ghbranchprotect"
-	"github.com/yoanm/go-gh2tf/ghrepository"
 	"github.com/yoanm/go-tfsig"
 )
 
 /** Public **/
 
 func NewHclRepository(repoTfId string, repoConfig *GhRepoConfig, valGen tfsig.ValueGenerator) *hclwrite.File {
+	return NewHclRepositoryWithContext(repoTfId, repoConfig, valGen, nil)
+}
+
+// NewHclRepositoryWithContext is the same as NewHclRepository, but uses the workspace context to link resources
+// declared in other files (teams for instance).
+func NewHclRepositoryWithContext(
+	repoTfId string,
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	ctx *WorkspaceContext,
+) *hclwrite.File {
 	hclFile := hclwrite.NewEmptyFile()
 
 	appendRepositoryResource(hclFile.Body(), repoConfig, valGen, repoTfId)
 	appendBranchDefaultResources(hclFile.Body(), repoConfig, valGen, repoTfId)
 	appendBranchResources(hclFile.Body(), repoConfig, valGen, repoTfId)
-	appendBranchProtectionResourceContent(hclFile.Body(), repoConfig, valGen, repoTfId)
+	appendBranchProtectionResourceContent(hclFile.Body(), repoConfig, valGen, repoTfId, ctx)
+	appendRulesetResources(hclFile.Body(), repoConfig, valGen, repoTfId, ctx)
+	appendEnvironmentResources(hclFile.Body(), repoConfig, valGen, repoTfId, ctx)
+	appendActionsResources(hclFile.Body(), repoConfig, valGen, repoTfId)
+	appendWebhookResources(hclFile.Body(), repoConfig, valGen, repoTfId)
+	appendLabelResources(hclFile.Body(), repoConfig, valGen, repoTfId)
+	appendRepositoryFileResources(hclFile.Body(), repoConfig, valGen, repoTfId)
+	appendSecurityResources(hclFile.Body(), repoConfig, valGen, repoTfId)
+	appendDeployKeyResources(hclFile.Body(), repoConfig, valGen, repoTfId)
+	appendAutolinkReferenceResources(hclFile.Body(), repoConfig, valGen, repoTfId)
+	appendCustomPropertyResources(hclFile.Body(), repoConfig, valGen, repoTfId, ctx)
+	appendTeamRepositoryResources(hclFile.Body(), repoConfig, valGen, repoTfId, ctx)
+	appendRepositoryCollaboratorResources(hclFile.Body(), repoConfig, valGen, repoTfId)
+	appendMovedBlocks(hclFile.Body(), repoConfig, valGen, repoTfId)
 
 	return hclFile
 }
@@ -26,12 +48,9 @@ func NewHclRepository(repoTfId string, repoConfig *GhRepoConfig, valGen tfsig.Va
 /** Private **/
 
 func appendRepositoryResource(body *hclwrite.Body, c *GhRepoConfig, valGen tfsig.ValueGenerator, repoTfId string) {
-	tfsig.AppendBlockIfNotNil(
-		body,
-		ghrepository.New(
-			MapToRepositoryRes(c, valGen, repoTfId),
-		),
-	)
+	if sig := NewRepositorySignature(c, valGen, repoTfId); sig != nil {
+		tfsig.AppendBlockIfNotNil(body, sig.Build())
+	}
 }
 
 func appendBranchDefaultResources(body *hclwrite.Body, c *GhRepoConfig, valGen tfsig.ValueGenerator, repoTfId string) {
@@ -136,10 +155,10 @@ func appendBranchProtectionResourceContent(
 	repoConfig *GhRepoConfig,
 	valGen tfsig.ValueGenerator,
 	repoTfId string,
+	ctx *WorkspaceContext,
 ) {
-	tfsig.AppendNewLineAndBlockIfNotNil(
-		body,
-		ghbranchprotect.New(
+	if repoConfig.DefaultBranch != nil {
+		sig := NewBranchProtectionSignature(
 			// /!\ use LinkToRepository, so underlying repository will have to be created before
 			// creating the branch protection
 			// /!\ use LinkToBranch, to explicitly bind the protection to the branch, so if something
@@ -153,8 +172,12 @@ func appendBranchProtectionResourceContent(
 				LinkToRepository,
 				LinkToBranch,
 			),
-		),
-	)
+			MapBranchToBranchProtectionExtraRes(repoConfig.DefaultBranch.Protection, valGen, ctx),
+		)
+		if sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
 
 	if repoConfig.Branches != nil {
 		// sort branches to always get a predictable output (for tests mostly)
@@ -163,33 +186,292 @@ func appendBranchProtectionResourceContent(
 		for idx, branchConfig := range branches {
 			key := keys[idx]
 
-			tfsig.AppendNewLineAndBlockIfNotNil(
-				body,
-				ghbranchprotect.New(
-					// /!\ use LinkToRepository, so underlying repository will have to be created before
-					// creating the branch protection
-					// /!\ do not use LinkToBranch, else branch protection will be created only after branch is created
-					// (which is useless and can be done in parallel)
-					MapBranchToBranchProtectionRes(&key, branchConfig.Protection, valGen, repoConfig, repoTfId, LinkToRepository),
-				),
+			sig := NewBranchProtectionSignature(
+				// /!\ use LinkToRepository, so underlying repository will have to be created before
+				// creating the branch protection
+				// /!\ do not use LinkToBranch, else branch protection will be created only after branch is created
+				// (which is useless and can be done in parallel)
+				MapBranchToBranchProtectionRes(&key, branchConfig.Protection, valGen, repoConfig, repoTfId, LinkToRepository),
+				MapBranchToBranchProtectionExtraRes(branchConfig.Protection, valGen, ctx),
 			)
+			if sig != nil {
+				tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+			}
 		}
 	}
 
 	if repoConfig.BranchProtections != nil {
 		for _, branchProtectionConfig := range *repoConfig.BranchProtections {
-			tfsig.AppendNewLineAndBlockIfNotNil(
-				body,
-				ghbranchprotect.New(
-					// /!\ use LinkToRepository, so underlying repository will have to be created before
-					// creating the branch protection
-					// /!\ do not use LinkToBranch, else branch protection will be created only after
-					// branch is created (which is useless and can be done in parallel) and in many cases related
-					// branch config doesn't exist anyway (else it's simpler to move the protection config under
-					// 'protection' attribute of the related branch)
-					MapToBranchProtectionRes(branchProtectionConfig, valGen, repoConfig, repoTfId, LinkToRepository),
-				),
+			sig := NewBranchProtectionSignature(
+				// /!\ use LinkToRepository, so underlying repository will have to be created before
+				// creating the branch protection
+				// /!\ do not use LinkToBranch, else branch protection will be created only after
+				// branch is created (which is useless and can be done in parallel) and in many cases related
+				// branch config doesn't exist anyway (else it's simpler to move the protection config under
+				// 'protection' attribute of the related branch)
+				MapToBranchProtectionRes(branchProtectionConfig, valGen, repoConfig, repoTfId, LinkToRepository),
+				MapToBranchProtectionExtraRes(branchProtectionConfig, valGen, ctx),
 			)
+			if sig != nil {
+				tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+			}
+		}
+	}
+}
+
+func appendRulesetResources(
+	body *hclwrite.Body,
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	ctx *WorkspaceContext,
+) {
+	// /!\ use LinkToRepository, so underlying repository will have to be created before creating the ruleset
+	for _, res := range MapToRepositoryRulesetResList(repoConfig, valGen, repoTfId, ctx, LinkToRepository) {
+		if sig := NewRepositoryRulesetSignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+}
+
+func appendEnvironmentResources(
+	body *hclwrite.Body,
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	ctx *WorkspaceContext,
+) {
+	// /!\ use LinkToRepository, so underlying repository will have to be created before creating the environment
+	for _, res := range MapToRepositoryEnvironmentResList(repoConfig, valGen, repoTfId, ctx, LinkToRepository) {
+		if sig := NewRepositoryEnvironmentSignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+
+	for _, res := range MapToRepositoryEnvironmentDeploymentPolicyResList(repoConfig, valGen, repoTfId, LinkToRepository) {
+		if sig := NewRepositoryEnvironmentDeploymentPolicySignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+}
+
+func appendActionsResources(
+	body *hclwrite.Body,
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+) {
+	// /!\ use LinkToRepository, so underlying repository will have to be created before configuring actions
+	permissionsRes := MapToActionsRepositoryPermissionsRes(repoConfig, valGen, repoTfId, LinkToRepository)
+	if sig := NewActionsRepositoryPermissionsSignature(permissionsRes); sig != nil {
+		tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+	}
+
+	workflowRes := MapToWorkflowRepositoryPermissionsRes(repoConfig, valGen, repoTfId, LinkToRepository)
+	if sig := NewWorkflowRepositoryPermissionsSignature(workflowRes); sig != nil {
+		tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+	}
+
+	for _, res := range MapToActionsVariableResList(repoConfig, valGen, repoTfId, LinkToRepository) {
+		if sig := NewActionsVariableSignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+
+	for _, res := range MapToActionsSecretResList(repoConfig, valGen, repoTfId, LinkToRepository) {
+		if sig := NewActionsSecretSignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+
+	for _, res := range MapToActionsEnvironmentVariableResList(repoConfig, valGen, repoTfId, LinkToRepository) {
+		if sig := NewActionsEnvironmentVariableSignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+
+	for _, res := range MapToActionsEnvironmentSecretResList(repoConfig, valGen, repoTfId, LinkToRepository) {
+		if sig := NewActionsEnvironmentSecretSignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+}
+
+func appendWebhookResources(
+	body *hclwrite.Body,
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+) {
+	// /!\ use LinkToRepository, so underlying repository will have to be created before creating webhooks
+	for _, res := range MapToWebhookResList(repoConfig, valGen, repoTfId, LinkToRepository) {
+		if sig := NewWebhookSignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+}
+
+func appendLabelResources(
+	body *hclwrite.Body,
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+) {
+	// /!\ use LinkToRepository, so underlying repository will have to be created before managing labels
+	if sig := NewIssueLabelsSignature(MapToIssueLabelsRes(repoConfig, valGen, repoTfId, LinkToRepository)); sig != nil {
+		tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+	}
+
+	for _, res := range MapToIssueLabelResList(repoConfig, valGen, repoTfId, LinkToRepository) {
+		if sig := NewIssueLabelSignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+}
+
+func appendRepositoryFileResources(
+	body *hclwrite.Body,
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+) {
+	// /!\ use LinkToRepository, so underlying repository will have to be created before committing files
+	// /!\ use LinkToBranch, so if a branch is configured, it will have to be created before committing files
+	for _, res := range MapToRepositoryFileResList(repoConfig, valGen, repoTfId, LinkToRepository, LinkToBranch) {
+		if sig := NewRepositoryFileSignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+}
+
+func appendSecurityResources(
+	body *hclwrite.Body,
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+) {
+	// /!\ use LinkToRepository, so underlying repository will have to be created before enabling security updates
+	dependabotRes := MapToDependabotSecurityUpdatesRes(repoConfig, valGen, repoTfId, LinkToRepository)
+	if sig := NewDependabotSecurityUpdatesSignature(dependabotRes); sig != nil {
+		tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+	}
+
+	reportingRes := MapToPrivateVulnerabilityReportingRes(repoConfig, valGen, repoTfId)
+	if sig := NewPrivateVulnerabilityReportingSignature(reportingRes); sig != nil {
+		tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+	}
+
+	codeScanningRes := MapToCodeScanningRes(repoConfig, valGen, repoTfId)
+	if sig := NewCodeScanningSignature(codeScanningRes); sig != nil {
+		tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+	}
+}
+
+func appendDeployKeyResources(
+	body *hclwrite.Body,
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+) {
+	// /!\ use LinkToRepository, so underlying repository will have to be created before adding deploy keys
+	for _, res := range MapToDeployKeyResList(repoConfig, valGen, repoTfId, LinkToRepository) {
+		if sig := NewDeployKeySignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+}
+
+func appendAutolinkReferenceResources(
+	body *hclwrite.Body,
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+) {
+	// /!\ use LinkToRepository, so underlying repository will have to be created before adding autolinks
+	for _, res := range MapToAutolinkReferenceResList(repoConfig, valGen, repoTfId, LinkToRepository) {
+		if sig := NewAutolinkReferenceSignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+}
+
+func appendCustomPropertyResources(
+	body *hclwrite.Body,
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	ctx *WorkspaceContext,
+) {
+	// /!\ use LinkToRepository, so underlying repository will have to be created before setting properties
+	for _, res := range MapToCustomPropertyResList(repoConfig, valGen, repoTfId, ctx, LinkToRepository) {
+		if sig := NewCustomPropertySignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+}
+
+func appendTeamRepositoryResources(
+	body *hclwrite.Body,
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+	ctx *WorkspaceContext,
+) {
+	// /!\ use LinkToRepository, so underlying repository will have to be created before granting the access
+	for _, res := range MapToTeamRepositoryResList(repoConfig, valGen, repoTfId, ctx, LinkToRepository) {
+		if sig := NewTeamRepositorySignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+}
+
+func appendRepositoryCollaboratorResources(
+	body *hclwrite.Body,
+	repoConfig *GhRepoConfig,
+	valGen tfsig.ValueGenerator,
+	repoTfId string,
+) {
+	// /!\ use LinkToRepository, so underlying repository will have to be created before granting the access
+	for _, res := range MapToRepositoryCollaboratorResList(repoConfig, valGen, repoTfId, LinkToRepository) {
+		if sig := NewRepositoryCollaboratorSignature(res); sig != nil {
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
+		}
+	}
+}
+
+func appendMovedBlocks(body *hclwrite.Body, repoConfig *GhRepoConfig, valGen tfsig.ValueGenerator, repoTfId string) {
+	if repoConfig.Terraform == nil || repoConfig.Terraform.PreviousNames == nil {
+		return
+	}
+
+	// Previous names are expected from the oldest to the most recent one, each of them is moved to the next one,
+	// and the most recent one is moved to the current name (terraform doesn't allow many moves to the same address)
+	idList := []string{}
+	seen := map[string]bool{repoTfId: true}
+
+	for _, previousName := range *repoConfig.Terraform.PreviousNames {
+		previousTfId := tfsig.ToTerraformIdentifier(previousName)
+		if !seen[previousTfId] {
+			seen[previousTfId] = true
+			idList = append(idList, previousTfId)
+		}
+	}
+
+	if len(idList) == 0 {
+		return
+	}
+
+	idList = append(idList, repoTfId)
+
+	for idx, fromTfId := range idList[:len(idList)-1] {
+		toList := NewRepositoryResources(idList[idx+1], repoConfig)
+
+		for resIdx, fromRes := range NewRepositoryResources(fromTfId, repoConfig) {
+			sig := tfsig.NewSignature("moved")
+			tfsig.AppendAttributeIfNotNil(sig, "from", valGen.ToIdent(&fromRes.Address))
+			tfsig.AppendAttributeIfNotNil(sig, "to", valGen.ToIdent(&toList[resIdx].Address))
+
+			tfsig.AppendNewLineAndBlockIfNotNil(body, sig.Build())
 		}
 	}
 }
@@ -0,0 +1,163 @@
+package core
+
+type GhRulesetsConfig []*GhRulesetConfig
+
+func (to *GhRulesetsConfig) Merge(from *GhRulesetsConfig) {
+	if from == nil {
+		return
+	}
+	// Duplicate every 'from' items to avoid overflow later
+	newItems := make(GhRulesetsConfig, len(*from))
+
+	for k, v := range *from {
+		//nolint:exhaustruct // No need here, it's base structure
+		newItem := &GhRulesetConfig{}
+		newItem.Merge(v)
+		newItems[k] = newItem
+	}
+
+	*to = append(*to, newItems...)
+}
+
+type GhRulesetConfig struct {
+	Name *string `yaml:"name,omitempty"`
+	//nolint:tagliatelle // yaml templates, not config templates => better to use underscore here
+	ConfigTemplates *[]string `yaml:"_templates,omitempty,flow"`
+	Target          *string   `yaml:"target,omitempty"`
+	Enforcement     *string   `yaml:"enforcement,omitempty"`
+	Include         *[]string `yaml:"include,omitempty,flow"`
+	Exclude         *[]string `yaml:"exclude,omitempty,flow"`
+
+	BypassActors *GhRulesetBypassActorsConfig `yaml:"bypass-actors,omitempty"`
+	Rules        *GhRulesetRulesConfig        `yaml:"rules,omitempty"`
+}
+
+func (to *GhRulesetConfig) Merge(from *GhRulesetConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeStringIfNotNil(&to.Name, from.Name)
+	mergeSliceIfNotNil(&to.ConfigTemplates, from.ConfigTemplates)
+	mergeStringIfNotNil(&to.Target, from.Target)
+	mergeStringIfNotNil(&to.Enforcement, from.Enforcement)
+	mergeSliceIfNotNil(&to.Include, from.Include)
+	mergeSliceIfNotNil(&to.Exclude, from.Exclude)
+
+	if from.BypassActors != nil {
+		if to.BypassActors == nil {
+			to.BypassActors = &GhRulesetBypassActorsConfig{}
+		}
+
+		to.BypassActors.Merge(from.BypassActors)
+	}
+
+	if from.Rules != nil {
+		if to.Rules == nil {
+			//nolint:exhaustruct // No need here, simple init
+			to.Rules = &GhRulesetRulesConfig{}
+		}
+
+		to.Rules.Merge(from.Rules)
+	}
+}
+
+type GhRulesetBypassActorsConfig []*GhRulesetBypassActorConfig
+
+func (to *GhRulesetBypassActorsConfig) Merge(from *GhRulesetBypassActorsConfig) {
+	if from == nil {
+		return
+	}
+	// Duplicate every 'from' items to avoid overflow later
+	newItems := make(GhRulesetBypassActorsConfig, len(*from))
+
+	for k, v := range *from {
+		//nolint:exhaustruct // No need here, it's base structure
+		newItem := &GhRulesetBypassActorConfig{}
+		newItem.Merge(v)
+		newItems[k] = newItem
+	}
+
+	*to = append(*to, newItems...)
+}
+
+type GhRulesetBypassActorConfig struct {
+	Type *string `yaml:"type,omitempty"`
+	Id   *string `yaml:"id,omitempty"`
+	Mode *string `yaml:"mode,omitempty"`
+}
+
+func (to *GhRulesetBypassActorConfig) Merge(from *GhRulesetBypassActorConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeStringIfNotNil(&to.Type, from.Type)
+	mergeStringIfNotNil(&to.Id, from.Id)
+	mergeStringIfNotNil(&to.Mode, from.Mode)
+}
+
+type GhRulesetRulesConfig struct {
+	RestrictCreation  *string `yaml:"restrict-creation,omitempty"`
+	RestrictUpdate    *string `yaml:"restrict-update,omitempty"`
+	RestrictDeletion  *string `yaml:"restrict-deletion,omitempty"`
+	RestrictForcePush *string `yaml:"restrict-force-push,omitempty"`
+	//nolint:tagliatelle // Already make sense for yaml config without the 'require' prefix (as it's a boolean)
+	RequireLinearHistory *string `yaml:"linear-history,omitempty"`
+	//nolint:tagliatelle // Already make sense for yaml config without the 'require' prefix (as it's a boolean)
+	RequireSignedCommits *string `yaml:"signed-commits,omitempty"`
+
+	StatusChecks       *GhBranchProtectStatusChecksConfig `yaml:"status-checks,omitempty"`
+	PullRequestReviews *GhRulesetPRReviewConfig           `yaml:"pull-request-reviews,omitempty"`
+}
+
+func (to *GhRulesetRulesConfig) Merge(from *GhRulesetRulesConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeStringIfNotNil(&to.RestrictCreation, from.RestrictCreation)
+	mergeStringIfNotNil(&to.RestrictUpdate, from.RestrictUpdate)
+	mergeStringIfNotNil(&to.RestrictDeletion, from.RestrictDeletion)
+	mergeStringIfNotNil(&to.RestrictForcePush, from.RestrictForcePush)
+	mergeStringIfNotNil(&to.RequireLinearHistory, from.RequireLinearHistory)
+	mergeStringIfNotNil(&to.RequireSignedCommits, from.RequireSignedCommits)
+
+	if from.StatusChecks != nil {
+		if to.StatusChecks == nil {
+			//nolint:exhaustruct // No need here, simple init
+			to.StatusChecks = &GhBranchProtectStatusChecksConfig{}
+		}
+
+		to.StatusChecks.Merge(from.StatusChecks)
+	}
+
+	if from.PullRequestReviews != nil {
+		if to.PullRequestReviews == nil {
+			//nolint:exhaustruct // No need here, simple init
+			to.PullRequestReviews = &GhRulesetPRReviewConfig{}
+		}
+
+		to.PullRequestReviews.Merge(from.PullRequestReviews)
+	}
+}
+
+type GhRulesetPRReviewConfig struct {
+	ApprovalCount         *string `yaml:"approval-count,omitempty"`
+	CodeownerApprovals    *string `yaml:"codeowner-approvals,omitempty"`
+	ResolvedConversations *string `yaml:"resolved-conversations,omitempty"`
+	LastPushApproval      *string `yaml:"last-push-approval,omitempty"`
+	DismissStaled         *string `yaml:"dismiss-staled,omitempty"`
+}
+
+func (to *GhRulesetPRReviewConfig) Merge(from *GhRulesetPRReviewConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeStringIfNotNil(&to.ApprovalCount, from.ApprovalCount)
+	mergeStringIfNotNil(&to.CodeownerApprovals, from.CodeownerApprovals)
+	mergeStringIfNotNil(&to.ResolvedConversations, from.ResolvedConversations)
+	mergeStringIfNotNil(&to.LastPushApproval, from.LastPushApproval)
+	mergeStringIfNotNil(&to.DismissStaled, from.DismissStaled)
+}
@@ -0,0 +1,137 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+func updateGhRulesetConfigHelper(c *core.GhRulesetConfig, stringToCopy *string, newSliceToCopy *[]string, updatePtr bool) {
+	updateStringPtrHelper(&c.Name, stringToCopy, updatePtr)
+	updateSlicePtrHelper(&c.ConfigTemplates, newSliceToCopy, updatePtr)
+	updateStringPtrHelper(&c.Target, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.Enforcement, stringToCopy, updatePtr)
+	updateSlicePtrHelper(&c.Include, newSliceToCopy, updatePtr)
+	updateSlicePtrHelper(&c.Exclude, newSliceToCopy, updatePtr)
+
+	if c.Rules == nil {
+		c.Rules = &core.GhRulesetRulesConfig{}
+	}
+
+	updateGhRulesetRulesConfigHelper(c.Rules, stringToCopy, newSliceToCopy, updatePtr)
+}
+
+func updateGhRulesetRulesConfigHelper(c *core.GhRulesetRulesConfig, stringToCopy *string, newSliceToCopy *[]string, updatePtr bool) {
+	updateStringPtrHelper(&c.RestrictCreation, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.RestrictUpdate, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.RestrictDeletion, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.RestrictForcePush, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.RequireLinearHistory, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.RequireSignedCommits, stringToCopy, updatePtr)
+
+	if c.StatusChecks == nil {
+		c.StatusChecks = &core.GhBranchProtectStatusChecksConfig{}
+	}
+
+	updateGhBranchProtectStatusChecksConfigHelper(c.StatusChecks, stringToCopy, newSliceToCopy, updatePtr)
+
+	if c.PullRequestReviews == nil {
+		c.PullRequestReviews = &core.GhRulesetPRReviewConfig{}
+	}
+
+	updateStringPtrHelper(&c.PullRequestReviews.ApprovalCount, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.PullRequestReviews.CodeownerApprovals, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.PullRequestReviews.ResolvedConversations, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.PullRequestReviews.LastPushApproval, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.PullRequestReviews.DismissStaled, stringToCopy, updatePtr)
+}
+
+func TestGhRulesetConfig_Merge(t *testing.T) {
+	t.Parallel()
+	// Ensure updating from afterward doesn't affect result of merge and vice versa
+	ensureMergeWithoutOverflowBetweenToAndFrom(
+		t,
+		core.GhRulesetConfig{},
+		func(to, from *core.GhRulesetConfig) {
+			to.Merge(from)
+		},
+		updateGhRulesetConfigHelper,
+	)
+}
+
+func TestGhRulesetConfig_Merge_2(t *testing.T) {
+	t.Parallel()
+
+	full1 := (*GetFullConfig(1).Rulesets)[0]
+	full2 := (*GetFullConfig(2).Rulesets)[0]
+	// manually generate result of full2 into full1
+	fullMergeResult := (*GetFullConfig(2).Rulesets)[0]
+	*fullMergeResult.ConfigTemplates = append(*(full1.ConfigTemplates), *(full2.ConfigTemplates)...)
+	*fullMergeResult.Include = append(*(full1.Include), *(full2.Include)...)
+	*fullMergeResult.Exclude = append(*(full1.Exclude), *(full2.Exclude)...)
+	*fullMergeResult.BypassActors = append(*(full1.BypassActors), *(full2.BypassActors)...)
+	*fullMergeResult.Rules.StatusChecks.Required = append(
+		*(full1.Rules.StatusChecks.Required),
+		*(full2.Rules.StatusChecks.Required)...,
+	)
+
+	cases := map[string]struct {
+		value    *core.GhRulesetConfig
+		from     *core.GhRulesetConfig
+		expected *core.GhRulesetConfig
+	}{
+		"full": {
+			full1,
+			full2,
+			fullMergeResult,
+		},
+		"to is empty": {
+			&core.GhRulesetConfig{},
+			(*GetFullConfig(1).Rulesets)[0],
+			(*GetFullConfig(1).Rulesets)[0],
+		},
+		"from nil": {
+			(*GetFullConfig(1).Rulesets)[0],
+			nil,
+			(*GetFullConfig(1).Rulesets)[0],
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+				tc.value.Merge(tc.from)
+
+				if diff := cmp.Diff(tc.expected, tc.value); diff != "" {
+					t.Errorf("Config mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
+
+func TestGhRulesetsConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	from := GetFullConfig(1).Rulesets
+	to := GetFullConfig(2).Rulesets
+	expected := GetFullConfig(2).Rulesets
+	*expected = append(*expected, *GetFullConfig(1).Rulesets...)
+
+	to.Merge(from)
+
+	if diff := cmp.Diff(expected, to); diff != "" {
+		t.Errorf("Config mismatch (-want +got):\n%s", diff)
+	}
+
+	// Ensure items have been copied
+	*(*from)[0].Name = "updated"
+
+	if diff := cmp.Diff(expected, to); diff != "" {
+		t.Errorf("Config mismatch after 'from' update (-want +got):\n%s", diff)
+	}
+}
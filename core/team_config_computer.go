@@ -0,0 +1,63 @@
+package core
+
+/** Public **/
+
+func ComputeTeamConfig(base *GhTeamConfig, templates *TemplatesConfig) (*GhTeamConfig, error) {
+	if base == nil {
+		return base, nil
+	}
+
+	if base.Name == nil {
+		return nil, ErrTeamNameIsMandatory
+	}
+
+	//nolint:exhaustruct // No need here, it's just the base structure
+	config := &GhTeamConfig{}
+	config.Merge(base)
+
+	config, err := ApplyTeamTemplate(config, templates)
+	if err != nil {
+		return nil, err
+	}
+
+	ConfigTrace("Final team config: "+(*base.Name), config)
+
+	return config, nil
+}
+
+func ApplyTeamTemplate(teamConfig *GhTeamConfig, templates *TemplatesConfig) (*GhTeamConfig, error) {
+	if teamConfig == nil || teamConfig.ConfigTemplates == nil {
+		return teamConfig, nil
+	}
+
+	if templates == nil {
+		return nil, NoTemplateAvailableError(TeamTemplateType)
+	}
+
+	tplList, err := LoadTemplateList(
+		teamConfig.ConfigTemplates,
+		func(s string) *GhTeamConfig {
+			return templates.GetTeam(s)
+		},
+		func(c *GhTeamConfig) *[]string {
+			return c.ConfigTemplates
+		},
+		TeamTemplateType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:exhaustruct // No need here, it's base structure
+	newConfig := &GhTeamConfig{}
+
+	for _, tpl := range tplList {
+		newConfig.Merge(tpl)
+	}
+
+	newConfig.Merge(teamConfig)
+	// Remove templates as they are applied
+	newConfig.ConfigTemplates = nil
+
+	return newConfig, nil
+}
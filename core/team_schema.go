@@ -0,0 +1,26 @@
+package core
+
+type GhTeamConfig struct {
+	Name *string `yaml:"name,omitempty"`
+	//nolint:tagliatelle // yaml templates, not config templates => better to use underscore here
+	ConfigTemplates *[]string `yaml:"_templates,omitempty,flow"`
+	Description     *string   `yaml:"description,omitempty"`
+	Privacy         *string   `yaml:"privacy,omitempty"`
+	Parent          *string   `yaml:"parent,omitempty"`
+	Maintainers     *[]string `yaml:"maintainers,omitempty"`
+	Members         *[]string `yaml:"members,omitempty"`
+}
+
+func (to *GhTeamConfig) Merge(from *GhTeamConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeStringIfNotNil(&to.Name, from.Name)
+	mergeSliceIfNotNil(&to.ConfigTemplates, from.ConfigTemplates)
+	mergeStringIfNotNil(&to.Description, from.Description)
+	mergeStringIfNotNil(&to.Privacy, from.Privacy)
+	mergeStringIfNotNil(&to.Parent, from.Parent)
+	mergeSliceIfNotNil(&to.Maintainers, from.Maintainers)
+	mergeSliceIfNotNil(&to.Members, from.Members)
+}
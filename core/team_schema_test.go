@@ -0,0 +1,80 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+func updateGhTeamConfigHelper(c *core.GhTeamConfig, stringToCopy *string, newSliceToCopy *[]string, updatePtr bool) {
+	updateStringPtrHelper(&c.Name, stringToCopy, updatePtr)
+	updateSlicePtrHelper(&c.ConfigTemplates, newSliceToCopy, updatePtr)
+	updateStringPtrHelper(&c.Description, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.Privacy, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.Parent, stringToCopy, updatePtr)
+	updateSlicePtrHelper(&c.Maintainers, newSliceToCopy, updatePtr)
+	updateSlicePtrHelper(&c.Members, newSliceToCopy, updatePtr)
+}
+
+func TestGhTeamConfig_Merge(t *testing.T) {
+	t.Parallel()
+	// Ensure updating from afterward doesn't affect result of merge and vice versa
+	ensureMergeWithoutOverflowBetweenToAndFrom(
+		t,
+		core.GhTeamConfig{},
+		func(to, from *core.GhTeamConfig) {
+			to.Merge(from)
+		},
+		updateGhTeamConfigHelper,
+	)
+}
+
+func TestGhTeamConfig_Merge_2(t *testing.T) {
+	t.Parallel()
+
+	full1 := GetFullTeamConfig(1)
+	full2 := GetFullTeamConfig(2)
+	// manually generate result of full2 into full1
+	fullMergeResult := GetFullTeamConfig(2)
+	*fullMergeResult.ConfigTemplates = append(*(full1.ConfigTemplates), *(full2.ConfigTemplates)...)
+	*fullMergeResult.Maintainers = append(*(full1.Maintainers), *(full2.Maintainers)...)
+	*fullMergeResult.Members = append(*(full1.Members), *(full2.Members)...)
+
+	cases := map[string]struct {
+		value    *core.GhTeamConfig
+		from     *core.GhTeamConfig
+		expected *core.GhTeamConfig
+	}{
+		"full": {
+			full1,
+			full2,
+			fullMergeResult,
+		},
+		"to is empty": {
+			&core.GhTeamConfig{},
+			GetFullTeamConfig(1),
+			GetFullTeamConfig(1),
+		},
+		"from nil": {
+			GetFullTeamConfig(1),
+			nil,
+			GetFullTeamConfig(1),
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+				tc.value.Merge(tc.from)
+
+				if diff := cmp.Diff(tc.expected, tc.value); diff != "" {
+					t.Errorf("Config mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
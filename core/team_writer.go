@@ -0,0 +1,28 @@
+package core
+
+import (
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+/** Public **/
+
+func NewHclTeam(
+	teamTfId string,
+	teamConfig *GhTeamConfig,
+	valGen tfsig.ValueGenerator,
+	ctx *WorkspaceContext,
+) *hclwrite.File {
+	hclFile := hclwrite.NewEmptyFile()
+
+	if sig := NewTeamSignature(MapToTeamRes(teamConfig, valGen, teamTfId, ctx)); sig != nil {
+		tfsig.AppendBlockIfNotNil(hclFile.Body(), sig.Build())
+	}
+
+	if sig := NewTeamMembersSignature(MapToTeamMembersRes(teamConfig, valGen, teamTfId)); sig != nil {
+		tfsig.AppendNewLineAndBlockIfNotNil(hclFile.Body(), sig.Build())
+	}
+
+	return hclFile
+}
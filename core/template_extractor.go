@@ -0,0 +1,281 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/goccy/go-yaml"
+)
+
+// DefaultTemplateExtractionMinRepos is the default minimum number of repositories which must share a section
+// for it to be extracted as template.
+const DefaultTemplateExtractionMinRepos = 2
+
+// ExtractedTemplates contains templates extracted by ExtractTemplates.
+type ExtractedTemplates struct {
+	// New repository templates, by template name
+	Repos map[string]*GhRepoConfig
+	// New branch protection templates, by template name
+	BranchProtections map[string]*GhBranchProtectionConfig
+	// Repository configs updated to use new templates, by repository name
+	UpdatedRepos map[string]*GhRepoConfig
+	// Provided repository configs, by repository name
+	originalRepos map[string]*GhRepoConfig
+}
+
+/** Public **/
+
+// ExtractTemplates looks for identical sections (pull-requests, misc, security, terraform and branch protections)
+// shared by at least minRepoCount repositories, and moves them to new templates.
+//
+// Provided config is left untouched: templates must be written, and config files updated with
+// ExtractedTemplates.RewriteRepoConfigFile. An error is returned if computed config with extracted templates differs
+// from the original computed config.
+func ExtractTemplates(config *Config, minRepoCount int) (*ExtractedTemplates, error) {
+	expected, err := ComputeConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	extracted := &ExtractedTemplates{
+		Repos:             map[string]*GhRepoConfig{},
+		BranchProtections: map[string]*GhBranchProtectionConfig{},
+		UpdatedRepos:      map[string]*GhRepoConfig{},
+		originalRepos:     map[string]*GhRepoConfig{},
+	}
+
+	newConfig := NewConfig()
+
+	if config.Templates != nil {
+		// New templates are added to the copy only
+		copyMap(newConfig.Templates.Repos, config.Templates.Repos)
+		copyMap(newConfig.Templates.Branches, config.Templates.Branches)
+		copyMap(newConfig.Templates.BranchProtections, config.Templates.BranchProtections)
+		copyMap(newConfig.Templates.Teams, config.Templates.Teams)
+		copyMap(newConfig.Templates.Rulesets, config.Templates.Rulesets)
+		copyMap(newConfig.Templates.Environments, config.Templates.Environments)
+		copyMap(newConfig.Templates.Webhooks, config.Templates.Webhooks)
+		copyMap(newConfig.Templates.Labels, config.Templates.Labels)
+	}
+
+	newConfig.Teams = append(newConfig.Teams, config.Teams...)
+
+	for _, repo := range config.Repos {
+		//nolint:exhaustruct // No need here, it's base structure
+		repoCopy := &GhRepoConfig{}
+		repoCopy.Merge(repo)
+		newConfig.AppendRepo(repoCopy)
+
+		extracted.originalRepos[*repo.Name] = repo
+	}
+
+	// Branch protections first, as repository templates are taken into account
+	extractBranchProtectionTemplates(newConfig, extracted, minRepoCount)
+
+	for _, section := range repoTemplateSections() {
+		extractRepoTemplates(newConfig, extracted, section, minRepoCount)
+	}
+
+	actual, err := ComputeConfig(newConfig)
+	if err != nil {
+		return nil, TemplateExtractionError([]error{err})
+	}
+
+	if errList := diffComputedRepos(expected, actual); len(errList) > 0 {
+		return nil, TemplateExtractionError(errList)
+	}
+
+	return extracted, nil
+}
+
+/** Private **/
+
+type repoTemplateSection struct {
+	name string
+	// getter returns a pointer to the section property, in order to be able to reset it
+	getter func(repo *GhRepoConfig) interface{}
+}
+
+func repoTemplateSections() []repoTemplateSection {
+	return []repoTemplateSection{
+		{"pull-requests", func(repo *GhRepoConfig) interface{} { return &repo.PullRequests }},
+		{"misc", func(repo *GhRepoConfig) interface{} { return &repo.Miscellaneous }},
+		{"security", func(repo *GhRepoConfig) interface{} { return &repo.Security }},
+		{"terraform", func(repo *GhRepoConfig) interface{} { return &repo.Terraform }},
+	}
+}
+
+func extractRepoTemplates(
+	config *Config,
+	extracted *ExtractedTemplates,
+	section repoTemplateSection,
+	minRepoCount int,
+) {
+	keyList, groupList := groupByCanonicalEncoding(
+		config.Repos,
+		func(repo *GhRepoConfig) interface{} {
+			if repo.ConfigTemplates != nil && len(*repo.ConfigTemplates) >= TemplateMaxCount {
+				return nil
+			}
+
+			return reflect.ValueOf(section.getter(repo)).Elem().Interface()
+		},
+	)
+
+	for _, key := range keyList {
+		repoList := groupList[key]
+		if len(repoList) < minRepoCount {
+			continue
+		}
+
+		tplName := nextFreeTemplateName(section.name, config.Templates.Repos)
+
+		//nolint:exhaustruct // No need here, it's base structure
+		tpl := &GhRepoConfig{}
+		tplSection := reflect.ValueOf(section.getter(tpl)).Elem()
+		tplSection.Set(reflect.ValueOf(section.getter(repoList[0])).Elem())
+
+		config.Templates.Repos[tplName] = tpl
+		extracted.Repos[tplName] = tpl
+
+		for _, repo := range repoList {
+			reflect.ValueOf(section.getter(repo)).Elem().Set(reflect.Zero(tplSection.Type()))
+			appendConfigTemplate(&repo.ConfigTemplates, tplName)
+			extracted.UpdatedRepos[*repo.Name] = repo
+		}
+	}
+}
+
+// extractBranchProtectionTemplates only manages protections without templates, and defined under a repository and
+// a branch without templates, as templates order matters (templates may define protections too).
+func extractBranchProtectionTemplates(config *Config, extracted *ExtractedTemplates, minRepoCount int) {
+	type protectionRef struct {
+		repo       *GhRepoConfig
+		protection *BaseGhBranchProtectionConfig
+	}
+
+	protectionList := []*protectionRef{}
+
+	for _, repo := range config.Repos {
+		if repo.ConfigTemplates != nil {
+			continue
+		}
+
+		if repo.DefaultBranch != nil && repo.DefaultBranch.ConfigTemplates == nil {
+			protectionList = append(protectionList, &protectionRef{repo, repo.DefaultBranch.Protection})
+		}
+
+		if repo.Branches != nil {
+			keys, branchList := MapToSortedListWithKeys(*repo.Branches)
+			for idx := range keys {
+				if branchList[idx].ConfigTemplates == nil {
+					protectionList = append(protectionList, &protectionRef{repo, branchList[idx].Protection})
+				}
+			}
+		}
+
+		if repo.BranchProtections != nil {
+			for _, protection := range *repo.BranchProtections {
+				protectionList = append(protectionList, &protectionRef{repo, &protection.BaseGhBranchProtectionConfig})
+			}
+		}
+	}
+
+	keyList, groupList := groupByCanonicalEncoding(
+		protectionList,
+		func(ref *protectionRef) interface{} {
+			if ref.protection == nil || ref.protection.ConfigTemplates != nil {
+				return nil
+			}
+
+			return ref.protection
+		},
+	)
+
+	for _, key := range keyList {
+		refList := groupList[key]
+
+		repoList := map[*GhRepoConfig]bool{}
+		for _, ref := range refList {
+			repoList[ref.repo] = true
+		}
+
+		if len(repoList) < minRepoCount {
+			continue
+		}
+
+		tplName := nextFreeTemplateName("protection", config.Templates.BranchProtections)
+
+		//nolint:exhaustruct // No need here, it's base structure
+		tpl := &GhBranchProtectionConfig{}
+		tpl.BaseGhBranchProtectionConfig.Merge(refList[0].protection)
+
+		config.Templates.BranchProtections[tplName] = tpl
+		extracted.BranchProtections[tplName] = tpl
+
+		for _, ref := range refList {
+			//nolint:exhaustruct // No need here, only templates are expected
+			*ref.protection = BaseGhBranchProtectionConfig{ConfigTemplates: &[]string{tplName}}
+			extracted.UpdatedRepos[*ref.repo.Name] = ref.repo
+		}
+	}
+}
+
+// groupByCanonicalEncoding groups items by the YAML encoding of the struct pointer returned by valueFn (nil and empty
+// values are ignored). Keys are returned in order of appearance.
+func groupByCanonicalEncoding[T any](list []T, valueFn func(item T) interface{}) ([]string, map[string][]T) {
+	keyList := []string{}
+	groupList := map[string][]T{}
+
+	for _, item := range list {
+		value := valueFn(item)
+		if value == nil || reflect.ValueOf(value).IsNil() || isEmptyStruct(value) {
+			continue
+		}
+
+		content, err := yaml.Marshal(value)
+		if err != nil {
+			continue
+		}
+
+		key := string(content)
+		if _, exists := groupList[key]; !exists {
+			keyList = append(keyList, key)
+		}
+
+		groupList[key] = append(groupList[key], item)
+	}
+
+	return keyList, groupList
+}
+
+func nextFreeTemplateName[T any](prefix string, existing map[string]T) string {
+	for idx := 1; ; idx++ {
+		name := fmt.Sprintf("%s-%d", prefix, idx)
+		if _, exists := existing[name]; !exists {
+			return name
+		}
+	}
+}
+
+func copyMap[T any](to map[string]T, from map[string]T) {
+	for k, v := range from {
+		to[k] = v
+	}
+}
+
+func appendConfigTemplate(list **[]string, tplName string) {
+	mergeSliceIfNotNil(list, &[]string{tplName})
+}
+
+func diffComputedRepos(expected *Config, actual *Config) []error {
+	errList := []error{}
+
+	for idx, repo := range expected.Repos {
+		if idx >= len(actual.Repos) || !reflect.DeepEqual(repo, actual.Repos[idx]) {
+			errList = append(errList, TemplateExtractionMismatchError(*repo.Name))
+		}
+	}
+
+	return errList
+}
@@ -0,0 +1,301 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+func TestExtractTemplates(t *testing.T) {
+	t.Parallel()
+
+	trueString := "true"
+	falseString := "false"
+	defaultBranchName := "master"
+	pattern := "release/*"
+	newRepo := func(name string, withTemplate bool) *core.GhRepoConfig {
+		repo := &core.GhRepoConfig{
+			Name:          &name,
+			Miscellaneous: &core.GhRepoMiscellaneousConfig{HasIssues: &trueString},
+			Security:      &core.GhRepoSecurityConfig{VulnerabilityAlerts: &falseString},
+			DefaultBranch: &core.GhDefaultBranchConfig{
+				Name: &defaultBranchName,
+				BaseGhBranchConfig: core.BaseGhBranchConfig{
+					Protection: &core.BaseGhBranchProtectionConfig{EnforceAdmins: &trueString},
+				},
+			},
+		}
+		if withTemplate {
+			repo.ConfigTemplates = &[]string{"misc-1"}
+		}
+
+		return repo
+	}
+	newTemplates := func() *core.TemplatesConfig {
+		return &core.TemplatesConfig{
+			Repos: map[string]*core.GhRepoConfig{
+				"misc-1": {Miscellaneous: &core.GhRepoMiscellaneousConfig{HasWiki: &falseString}},
+			},
+			Branches:          map[string]*core.GhBranchConfig{},
+			BranchProtections: map[string]*core.GhBranchProtectionConfig{},
+		}
+	}
+	cases := map[string]struct {
+		repos        []*core.GhRepoConfig
+		minRepoCount int
+		expected     *core.ExtractedTemplates
+	}{
+		"Nothing shared": {
+			[]*core.GhRepoConfig{newRepo("repo1", false)},
+			2,
+			&core.ExtractedTemplates{
+				Repos:             map[string]*core.GhRepoConfig{},
+				BranchProtections: map[string]*core.GhBranchProtectionConfig{},
+				UpdatedRepos:      map[string]*core.GhRepoConfig{},
+			},
+		},
+		"Shared sections": {
+			[]*core.GhRepoConfig{newRepo("repo1", false), newRepo("repo2", true)},
+			2,
+			&core.ExtractedTemplates{
+				Repos: map[string]*core.GhRepoConfig{
+					"misc-2":     {Miscellaneous: &core.GhRepoMiscellaneousConfig{HasIssues: &trueString}},
+					"security-1": {Security: &core.GhRepoSecurityConfig{VulnerabilityAlerts: &falseString}},
+				},
+				BranchProtections: map[string]*core.GhBranchProtectionConfig{},
+				UpdatedRepos: map[string]*core.GhRepoConfig{
+					"repo1": {
+						Name:            newRepo("repo1", false).Name,
+						ConfigTemplates: &[]string{"misc-2", "security-1"},
+						DefaultBranch:   newRepo("repo1", false).DefaultBranch,
+					},
+					"repo2": {
+						Name:            newRepo("repo2", false).Name,
+						ConfigTemplates: &[]string{"misc-1", "misc-2", "security-1"},
+						DefaultBranch:   newRepo("repo2", false).DefaultBranch,
+					},
+				},
+			},
+		},
+		"Shared protections": {
+			[]*core.GhRepoConfig{
+				{
+					Name:              newRepo("repo1", false).Name,
+					DefaultBranch:     newRepo("repo1", false).DefaultBranch,
+					BranchProtections: &core.GhBranchProtectionsConfig{{Pattern: &pattern}},
+				},
+				{
+					Name: newRepo("repo2", false).Name,
+					BranchProtections: &core.GhBranchProtectionsConfig{
+						{
+							Pattern:                      &pattern,
+							BaseGhBranchProtectionConfig: core.BaseGhBranchProtectionConfig{EnforceAdmins: &trueString},
+						},
+					},
+				},
+			},
+			2,
+			&core.ExtractedTemplates{
+				Repos: map[string]*core.GhRepoConfig{},
+				BranchProtections: map[string]*core.GhBranchProtectionConfig{
+					"protection-1": {
+						BaseGhBranchProtectionConfig: core.BaseGhBranchProtectionConfig{EnforceAdmins: &trueString},
+					},
+				},
+				UpdatedRepos: map[string]*core.GhRepoConfig{
+					"repo1": {
+						Name: newRepo("repo1", false).Name,
+						DefaultBranch: &core.GhDefaultBranchConfig{
+							Name: &defaultBranchName,
+							BaseGhBranchConfig: core.BaseGhBranchConfig{
+								Protection: &core.BaseGhBranchProtectionConfig{ConfigTemplates: &[]string{"protection-1"}},
+							},
+						},
+						BranchProtections: &core.GhBranchProtectionsConfig{{Pattern: &pattern}},
+					},
+					"repo2": {
+						Name: newRepo("repo2", false).Name,
+						BranchProtections: &core.GhBranchProtectionsConfig{
+							{
+								Pattern: &pattern,
+								BaseGhBranchProtectionConfig: core.BaseGhBranchProtectionConfig{
+									ConfigTemplates: &[]string{"protection-1"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"Not enough repositories": {
+			[]*core.GhRepoConfig{newRepo("repo1", false), newRepo("repo2", false)},
+			3,
+			&core.ExtractedTemplates{
+				Repos:             map[string]*core.GhRepoConfig{},
+				BranchProtections: map[string]*core.GhBranchProtectionConfig{},
+				UpdatedRepos:      map[string]*core.GhRepoConfig{},
+			},
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				config := &core.Config{Templates: newTemplates(), Repos: tc.repos}
+
+				actual, err := core.ExtractTemplates(config, tc.minRepoCount)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if diff := cmp.Diff(tc.expected, actual, cmpopts.IgnoreUnexported(core.ExtractedTemplates{})); diff != "" {
+					t.Errorf("Extracted templates mismatch (-want +got):\n%s", diff)
+				}
+
+				if len(config.Templates.Repos) != 1 || len(config.Templates.BranchProtections) != 0 {
+					t.Errorf("Provided config is expected to be left untouched")
+				}
+			},
+		)
+	}
+}
+
+func TestExtractedTemplates_RewriteRepoConfigFile(t *testing.T) {
+	t.Parallel()
+
+	newTemplates := func() *core.TemplatesConfig {
+		//nolint:exhaustruct // No need here, only used templates are provided
+		return &core.TemplatesConfig{
+			Repos:             map[string]*core.GhRepoConfig{},
+			Branches:          map[string]*core.GhBranchConfig{},
+			BranchProtections: map[string]*core.GhBranchProtectionConfig{},
+		}
+	}
+
+	cases := map[string]struct {
+		content          string
+		expected         string
+		expectedRepoList []string
+		expectedError    string
+	}{
+		"Comments, order and anchors are kept": {
+			`# Header
+- name: repo1 # Main repository
+  # Removed with its section
+  misc:
+    topics: [ go ]
+  description: &description A repository
+- misc:
+    topics: [ go ]
+  name: repo2
+  description: *description
+`,
+			`# Header
+- name: repo1 # Main repository
+  _templates: [misc-1]
+  description: &description A repository
+- name: repo2
+  _templates: [misc-1]
+  description: *description
+`,
+			[]string{"repo1", "repo2"},
+			"",
+		},
+		"Branch protections": {
+			`- name: repo1
+  default-branch:
+    name: master
+    protection:
+      enforce-admins: true # Admins too
+- name: repo2
+  branch-protections:
+    - enforce-admins: true
+      pattern: release/*
+      forbid: false
+`,
+			`- name: repo1
+  default-branch:
+    name: master
+    protection:
+      _templates: [protection-1]
+- name: repo2
+  branch-protections:
+    - pattern: release/*
+      _templates: [protection-1]
+      forbid: false
+`,
+			[]string{"repo1", "repo2"},
+			"",
+		},
+		"Removed anchor": {
+			`- name: repo1
+  misc: &misc
+    topics: [ go ]
+- name: repo2
+  misc: *misc
+`,
+			"",
+			nil,
+			"repository repo1: misc: YAML anchor or alias can't be rewritten",
+		},
+		"Flow style mapping": {
+			`- {name: repo1, misc: {topics: [ go ]}}
+- {name: repo2, misc: {topics: [ go ]}}
+`,
+			"",
+			nil,
+			"repository repo1: flow style mapping can't be rewritten",
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				var repoList []*core.GhRepoConfig
+				if err := yaml.Unmarshal([]byte(tc.content), &repoList); err != nil {
+					t.Fatal(err)
+				}
+
+				extracted, err := core.ExtractTemplates(&core.Config{Templates: newTemplates(), Repos: repoList}, 2)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				actual, actualRepoList, err := extracted.RewriteRepoConfigFile([]byte(tc.content))
+				if tc.expectedError != "" {
+					if err == nil || err.Error() != tc.expectedError {
+						t.Fatalf("Expected error %q, got %v", tc.expectedError, err)
+					}
+
+					return
+				} else if err != nil {
+					t.Fatal(err)
+				}
+
+				if diff := cmp.Diff(tc.expected, string(actual)); diff != "" {
+					t.Errorf("Content mismatch (-want +got):\n%s", diff)
+				}
+
+				if diff := cmp.Diff(tc.expectedRepoList, actualRepoList); diff != "" {
+					t.Errorf("Rewritten repositories mismatch (-want +got):\n%s", diff)
+				}
+
+				// Unchanged content is kept as is
+				unchanged, unchangedRepoList, err := extracted.RewriteRepoConfigFile([]byte("name: another-repo\n"))
+				if err != nil || string(unchanged) != "name: another-repo\n" || len(unchangedRepoList) != 0 {
+					t.Errorf("Content of other repositories is expected to be left untouched")
+				}
+			},
+		)
+	}
+}
@@ -4,6 +4,11 @@ const (
 	RepositoryTemplateType       = "repository"
 	BranchTemplateType           = "branch"
 	BranchProtectionTemplateType = "branch protection"
+	TeamTemplateType             = "team"
+	RulesetTemplateType          = "ruleset"
+	EnvironmentTemplateType      = "environment"
+	WebhookTemplateType          = "webhook"
+	LabelsTemplateType           = "labels"
 
 	TemplateMaxDepth = 10
 	TemplateMaxCount = 10
@@ -0,0 +1,194 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/andreyvit/diff"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+const (
+	diffContextLineCount = 3
+	diffNoFile           = "/dev/null"
+)
+
+/** Public **/
+
+// DiffTerraformFiles compares provided files, once formatted, with existing files located under rootPath.
+//
+// It returns a unified diff for each file which differs, including orphaned files (see PruneTerraformFiles)
+// which would be removed.
+func DiffTerraformFiles(rootPath string, files map[string]*hclwrite.File) (map[string]string, error) {
+	diffList := map[string]string{}
+	errList := map[string]error{}
+
+	for fName, hclFile := range files {
+		filePath := path.Join(rootPath, fName)
+
+		current, exists, err := readExistingFile(filePath)
+		if err != nil {
+			errList[fName] = err
+
+			continue
+		}
+
+		fromPath := filePath
+		if !exists {
+			fromPath = diffNoFile
+		}
+
+		if diff := UnifiedDiff(fromPath, filePath, current, string(hclwrite.Format(hclFile.Bytes()))); diff != "" {
+			diffList[fName] = diff
+		}
+	}
+
+	previousList, err := ReadTerraformManifest(rootPath)
+	if err != nil {
+		errList[ManifestFilename] = err
+	}
+
+	for _, fName := range previousList {
+		if _, generated := files[fName]; generated {
+			continue
+		}
+
+		filePath := path.Join(rootPath, fName)
+
+		current, exists, readErr := readExistingFile(filePath)
+		if readErr != nil {
+			errList[fName] = readErr
+		} else if exists {
+			diffList[fName] = UnifiedDiff(filePath, diffNoFile, current, "")
+		}
+	}
+
+	if len(errList) > 0 {
+		return nil, TerraformFilesCheckError(MapToSortedList(errList))
+	}
+
+	return diffList, nil
+}
+
+// UnifiedDiff returns the unified diff between 'from' and 'to' contents, empty string if they are identical.
+func UnifiedDiff(fromPath string, toPath string, from string, to string) string {
+	if from == to {
+		return ""
+	}
+
+	lines := diff.LineDiffAsLines(from, to)
+
+	builder := &strings.Builder{}
+	builder.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", fromPath, toPath))
+
+	for _, hunk := range computeDiffHunks(lines) {
+		builder.WriteString(hunk)
+	}
+
+	return builder.String()
+}
+
+/** Private **/
+
+func readExistingFile(filePath string) (string, bool, error) {
+	content, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+
+	return string(content), true, nil
+}
+
+// computeDiffHunks groups diff lines (prefixed by " ", "-" or "+") into unified diff hunks.
+func computeDiffHunks(lines []string) []string {
+	hunkList := []string{}
+	lineCount := len(lines)
+	idx := 0
+
+	for idx < lineCount {
+		if strings.HasPrefix(lines[idx], " ") {
+			idx++
+
+			continue
+		}
+
+		start := max(0, idx-diffContextLineCount)
+		end := idx
+
+		// Extend the hunk while next change is close enough to share context lines
+		for end < lineCount {
+			if !strings.HasPrefix(lines[end], " ") {
+				end++
+
+				continue
+			}
+
+			nextChange := end
+			for nextChange < lineCount && strings.HasPrefix(lines[nextChange], " ") {
+				nextChange++
+			}
+
+			if nextChange == lineCount || nextChange-end > 2*diffContextLineCount {
+				end = min(lineCount, end+diffContextLineCount)
+
+				break
+			}
+
+			end = nextChange
+		}
+
+		hunkList = append(hunkList, formatDiffHunk(lines, start, end))
+		idx = end
+	}
+
+	return hunkList
+}
+
+func formatDiffHunk(lines []string, start int, end int) string {
+	oldStart, newStart := 1, 1
+
+	for _, line := range lines[:start] {
+		if !strings.HasPrefix(line, "+") {
+			oldStart++
+		}
+
+		if !strings.HasPrefix(line, "-") {
+			newStart++
+		}
+	}
+
+	oldCount, newCount := 0, 0
+
+	for _, line := range lines[start:end] {
+		if !strings.HasPrefix(line, "+") {
+			oldCount++
+		}
+
+		if !strings.HasPrefix(line, "-") {
+			newCount++
+		}
+	}
+
+	// Unified diff format expects the line before the hunk when it's empty
+	if oldCount == 0 {
+		oldStart--
+	}
+
+	if newCount == 0 {
+		newStart--
+	}
+
+	return fmt.Sprintf(
+		"@@ -%d,%d +%d,%d @@\n%s\n",
+		oldStart,
+		oldCount,
+		newStart,
+		newCount,
+		strings.Join(lines[start:end], "\n"),
+	)
+}
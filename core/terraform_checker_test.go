@@ -0,0 +1,119 @@
+package core_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/andreyvit/diff"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		from     string
+		to       string
+		expected string
+	}{
+		"Identical": {
+			"a\nb\n",
+			"a\nb\n",
+			"",
+		},
+		"New file": {
+			"",
+			"a\nb\n",
+			"--- from\n+++ to\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		"Removed file": {
+			"a\nb\n",
+			"",
+			"--- from\n+++ to\n@@ -1,2 +0,0 @@\n-a\n-b\n",
+		},
+		"Multiple hunks": {
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			"1\n2\nthree\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n",
+			"--- from\n+++ to\n" +
+				"@@ -1,6 +1,6 @@\n 1\n 2\n-3\n+three\n 4\n 5\n 6\n" +
+				"@@ -10,3 +10,4 @@\n 10\n 11\n 12\n+13\n",
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				if actual := core.UnifiedDiff("from", "to", tc.from, tc.to); actual != tc.expected {
+					t.Errorf("Diff mismatch\n- expected\n+ actual\n\n%v", diff.LineDiff(tc.expected, actual))
+				}
+			},
+		)
+	}
+}
+
+func TestDiffTerraformFiles(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	upToDate := hclwrite.NewEmptyFile()
+	upToDate.Body().AppendBlock(hclwrite.NewBlock("type1", []string{"label1"}))
+	changed := hclwrite.NewEmptyFile()
+	changed.Body().AppendBlock(hclwrite.NewBlock("type2", []string{"label2"}))
+
+	existingFiles := map[string]string{
+		"repo.up-to-date.tf":  "type1 \"label1\" {\n}\n",
+		"repo.changed.tf":     "type2 \"label\" {\n}\n",
+		"repo.orphan.tf":      "type3 \"label3\" {\n}\n",
+		"main.tf":             "provider \"github\" {}\n",
+		core.ManifestFilename: "repo.up-to-date.tf\nrepo.changed.tf\nrepo.orphan.tf\n",
+	}
+	for fName, content := range existingFiles {
+		if err := os.WriteFile(path.Join(root, fName), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	actual, err := core.DiffTerraformFiles(
+		root,
+		map[string]*hclwrite.File{
+			"repo.up-to-date.tf": upToDate,
+			"repo.changed.tf":    changed,
+			"repo.new.tf":        upToDate,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"repo.changed.tf": core.UnifiedDiff(
+			path.Join(root, "repo.changed.tf"),
+			path.Join(root, "repo.changed.tf"),
+			existingFiles["repo.changed.tf"],
+			"type2 \"label2\" {\n}\n",
+		),
+		"repo.new.tf": core.UnifiedDiff(
+			"/dev/null",
+			path.Join(root, "repo.new.tf"),
+			"",
+			existingFiles["repo.up-to-date.tf"],
+		),
+		"repo.orphan.tf": core.UnifiedDiff(
+			path.Join(root, "repo.orphan.tf"),
+			"/dev/null",
+			existingFiles["repo.orphan.tf"],
+			"",
+		),
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("Diff mismatch (-want +got):\n%s", diff)
+	}
+}
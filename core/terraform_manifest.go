@@ -0,0 +1,123 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// ManifestFilename is the name of the file listing terraform files generated by a previous run.
+const ManifestFilename = ".github-tf.manifest"
+
+const manifestHeader = "# Terraform files managed by github-tf, do not edit !"
+
+/** Public **/
+
+// ReadTerraformManifest returns the list of files generated by a previous run, nil if there is no manifest.
+func ReadTerraformManifest(rootPath string) ([]string, error) {
+	content, err := os.ReadFile(path.Join(rootPath, ManifestFilename))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, FileError(ManifestFilename, err)
+	}
+
+	list := []string{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// Only terraform files located directly under the root path are expected, anything else is ignored
+		// to avoid removing unexpected files
+		if filepath.Base(line) != line || filepath.Ext(line) != ".tf" {
+			continue
+		}
+
+		list = append(list, line)
+	}
+
+	return list, nil
+}
+
+// WriteTerraformManifest writes the manifest listing provided generated files.
+func WriteTerraformManifest(rootPath string, filenames []string) error {
+	list := append([]string{}, filenames...)
+	sort.Strings(list)
+
+	content := manifestHeader + "\n" + strings.Join(list, "\n") + "\n"
+
+	//nolint:gomnd,gosec // Same permissions as terraform files
+	if err := os.WriteFile(path.Join(rootPath, ManifestFilename), []byte(content), 0o644); err != nil {
+		return FileError(ManifestFilename, err)
+	}
+
+	return nil
+}
+
+// PruneTerraformFiles removes files generated by a previous run which are not part of provided files anymore,
+// and updates the manifest accordingly.
+//
+// Only files listed in the manifest are considered, meaning hand-written files are never removed.
+// In case dryRun is true, orphaned files are neither removed nor removed from the manifest.
+// It returns the list of orphaned files.
+func PruneTerraformFiles(rootPath string, files map[string]*hclwrite.File, dryRun bool) ([]string, error) {
+	previousList, err := ReadTerraformManifest(rootPath)
+	if err != nil {
+		return nil, TerraformFilesPruningError([]error{err})
+	}
+
+	if previousList == nil && len(files) == 0 {
+		// Nothing generated, neither now nor previously
+		return nil, nil
+	}
+
+	orphanList := []string{}
+
+	for _, fName := range previousList {
+		if _, exists := files[fName]; exists {
+			continue
+		}
+
+		if _, statErr := os.Stat(path.Join(rootPath, fName)); statErr == nil {
+			orphanList = append(orphanList, fName)
+		}
+	}
+
+	managedList := make([]string, 0, len(files)+len(orphanList))
+	for fName := range files {
+		managedList = append(managedList, fName)
+	}
+
+	var errList []error
+
+	for _, fName := range orphanList {
+		if dryRun {
+			managedList = append(managedList, fName)
+		} else if rmErr := os.Remove(path.Join(rootPath, fName)); rmErr != nil {
+			// Keep it managed, so it will be removed on next run
+			managedList = append(managedList, fName)
+			errList = append(errList, rmErr)
+		}
+	}
+
+	if err = WriteTerraformManifest(rootPath, managedList); err != nil {
+		errList = append(errList, err)
+	}
+
+	if len(errList) > 0 {
+		return orphanList, TerraformFilesPruningError(errList)
+	}
+
+	return orphanList, nil
+}
@@ -0,0 +1,127 @@
+package core_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+func TestPruneTerraformFiles(t *testing.T) {
+	t.Parallel()
+
+	generated := map[string]*hclwrite.File{"repo.repo1.tf": hclwrite.NewEmptyFile()}
+	cases := map[string]struct {
+		manifest         *string
+		files            map[string]*hclwrite.File
+		dryRun           bool
+		expectedOrphans  []string
+		expectedManifest []string
+		expectedFiles    []string
+	}{
+		"Nothing generated": {
+			nil,
+			nil,
+			false,
+			nil,
+			nil,
+			[]string{"main.tf", "repo.old.tf", "repo.repo1.tf"},
+		},
+		"No manifest": {
+			nil,
+			generated,
+			false,
+			[]string{},
+			[]string{"repo.repo1.tf"},
+			[]string{"main.tf", "repo.old.tf", "repo.repo1.tf"},
+		},
+		"With orphans": {
+			toPointer("# comment\nrepo.old.tf\nrepo.repo1.tf\nrepo.unknown.tf\n"),
+			generated,
+			false,
+			[]string{"repo.old.tf"},
+			[]string{"repo.repo1.tf"},
+			[]string{"main.tf", "repo.repo1.tf"},
+		},
+		"With orphans - dry run": {
+			toPointer("repo.old.tf\nrepo.repo1.tf\n"),
+			generated,
+			true,
+			[]string{"repo.old.tf"},
+			[]string{"repo.old.tf", "repo.repo1.tf"},
+			[]string{"main.tf", "repo.old.tf", "repo.repo1.tf"},
+		},
+		"Unexpected manifest entries": {
+			toPointer("../main.tf\nsub/repo.old.tf\nREADME.md\nrepo.repo1.tf\n"),
+			generated,
+			false,
+			[]string{},
+			[]string{"repo.repo1.tf"},
+			[]string{"main.tf", "repo.old.tf", "repo.repo1.tf"},
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				root := t.TempDir()
+				for _, fName := range []string{"main.tf", "repo.old.tf", "repo.repo1.tf"} {
+					if err := os.WriteFile(path.Join(root, fName), []byte{}, 0o600); err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				if tc.manifest != nil {
+					if err := os.WriteFile(path.Join(root, core.ManifestFilename), []byte(*tc.manifest), 0o600); err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				orphans, err := core.PruneTerraformFiles(root, tc.files, tc.dryRun)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if diff := cmp.Diff(tc.expectedOrphans, orphans); diff != "" {
+					t.Errorf("Orphans mismatch (-want +got):\n%s", diff)
+				}
+
+				manifest, err := core.ReadTerraformManifest(root)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if diff := cmp.Diff(tc.expectedManifest, manifest); diff != "" {
+					t.Errorf("Manifest mismatch (-want +got):\n%s", diff)
+				}
+
+				entries, err := os.ReadDir(root)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				actualFiles := []string{}
+				for _, entry := range entries {
+					if entry.Name() != core.ManifestFilename {
+						actualFiles = append(actualFiles, entry.Name())
+					}
+				}
+
+				if diff := cmp.Diff(tc.expectedFiles, actualFiles); diff != "" {
+					t.Errorf("Files mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
+
+func toPointer(s string) *string {
+	return &s
+}
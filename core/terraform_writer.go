@@ -14,44 +14,72 @@ import (
 	"github.com/yoanm/go-tfsig"
 )
 
+const (
+	// ImportsFilename is the name of the file containing import blocks.
+	ImportsFilename = "imports.tf"
+	// OrgFilename is the name of the file containing organization settings.
+	OrgFilename = "org.tf"
+	// OrgRulesetsFilename is the name of the file containing organization rulesets.
+	OrgRulesetsFilename = "org-rulesets.tf"
+	// VariablesFilename is the name of the file declaring terraform input variables (secret values for instance).
+	VariablesFilename = "variables.tf"
+)
+
 /** Public **/
 
-func GenerateHclRepoFiles(configList []*GhRepoConfig) (map[string]*hclwrite.File, error) {
-	valueGenerator := gh2tf.NewValueGenerator()
-	waitGroup := &sync.WaitGroup{}
-	collector := make(fileCollector, len(configList))
-	errCollector := make(errorCollector, len(configList))
+// GenerateHclFiles returns terraform files for every repository and team of the (computed) config, as well as
+// organization settings, rulesets and input variables if any.
+func GenerateHclFiles(config *Config) (map[string]*hclwrite.File, error) {
+	ctx := NewWorkspaceContext(config)
+
+	list, err := generateHclRepoFiles(config.Repos, ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	var errList []error
 
-	for k, repoConfig := range configList {
-		waitGroup.Add(1)
+	valueGenerator := gh2tf.NewValueGenerator()
 
-		if repoConfig.Name == nil {
-			errList = append(errList, RepositoryNameIsMandatoryForConfigIndexError(k))
+	for k, teamConfig := range config.Teams {
+		if teamConfig.Name == nil {
+			errList = append(errList, TeamNameIsMandatoryForTeamError(k))
 		} else {
-			repoTfId := tfsig.ToTerraformIdentifier(*repoConfig.Name)
-			go generateHclRepoFileAsync(repoConfig, valueGenerator, repoTfId, collector, waitGroup)
+			teamTfId := tfsig.ToTerraformIdentifier(*teamConfig.Name)
+			list[fmt.Sprintf("team.%s.tf", teamTfId)] = NewHclTeam(teamTfId, teamConfig, valueGenerator, ctx)
 		}
 	}
 
-	waitGroup.Wait()
-	close(collector)
-	close(errCollector)
+	if len(errList) > 0 {
+		return nil, FileGenerationError(createFileGenerationErrorMessages(nil, errList))
+	}
 
-	if len(errCollector) > 0 || len(errList) > 0 {
-		return nil, FileGenerationError(createFileGenerationErrorMessages(errCollector, errList))
+	if config.Org != nil {
+		list[OrgFilename] = NewHclOrg(config.Org, valueGenerator)
 	}
 
-	list := map[string]*hclwrite.File{}
+	if len(config.OrgRulesets) > 0 {
+		list[OrgRulesetsFilename] = NewHclOrgRulesets(config.OrgRulesets, valueGenerator, config.Repos, ctx)
+	}
 
-	for fs := range collector {
-		list[fs.name] = fs.file
+	if variablesFile := NewHclVariables(config.Repos, valueGenerator); variablesFile != nil {
+		list[VariablesFilename] = variablesFile
 	}
 
 	return list, nil
 }
 
+// GenerateHclRepoFiles returns terraform files for provided repositories only, resources related to other config
+// sections (teams for instance) are referenced as is.
+func GenerateHclRepoFiles(configList []*GhRepoConfig) (map[string]*hclwrite.File, error) {
+	return generateHclRepoFiles(configList, nil)
+}
+
+// GenerateHclImportFile returns a file containing an "import" block for each resource.
+func GenerateHclImportFile(resources []*TerraformResource) *hclwrite.File {
+	return NewHclImports(resources, gh2tf.NewValueGenerator())
+}
+
 func WriteTerraformFiles(rootPath string, files map[string]*hclwrite.File) error {
 	if len(files) == 0 {
 		return nil
@@ -98,10 +126,47 @@ type (
 	errorCollector chan errorCollectorItem
 )
 
+func generateHclRepoFiles(configList []*GhRepoConfig, ctx *WorkspaceContext) (map[string]*hclwrite.File, error) {
+	valueGenerator := gh2tf.NewValueGenerator()
+	waitGroup := &sync.WaitGroup{}
+	collector := make(fileCollector, len(configList))
+	errCollector := make(errorCollector, len(configList))
+
+	var errList []error
+
+	for k, repoConfig := range configList {
+		waitGroup.Add(1)
+
+		if repoConfig.Name == nil {
+			errList = append(errList, RepositoryNameIsMandatoryForConfigIndexError(k))
+		} else {
+			repoTfId := tfsig.ToTerraformIdentifier(*repoConfig.Name)
+			go generateHclRepoFileAsync(repoConfig, valueGenerator, repoTfId, ctx, collector, waitGroup)
+		}
+	}
+
+	waitGroup.Wait()
+	close(collector)
+	close(errCollector)
+
+	if len(errCollector) > 0 || len(errList) > 0 {
+		return nil, FileGenerationError(createFileGenerationErrorMessages(errCollector, errList))
+	}
+
+	list := map[string]*hclwrite.File{}
+
+	for fs := range collector {
+		list[fs.name] = fs.file
+	}
+
+	return list, nil
+}
+
 func generateHclRepoFileAsync(
 	repoConfig *GhRepoConfig,
 	valGen tfsig.ValueGenerator,
 	repoTfId string,
+	ctx *WorkspaceContext,
 	collector fileCollector,
 	wg *sync.WaitGroup,
 ) {
@@ -109,7 +174,7 @@ func generateHclRepoFileAsync(
 
 	fname := fmt.Sprintf("repo.%s.tf", repoTfId)
 
-	collector <- fileCollectorItem{name: fname, file: NewHclRepository(repoTfId, repoConfig, valGen)}
+	collector <- fileCollectorItem{name: fname, file: NewHclRepositoryWithContext(repoTfId, repoConfig, valGen, ctx)}
 }
 
 func writeTerraformFileAsync(path string, hclFile *hclwrite.File, errCollector errorCollector, wg *sync.WaitGroup) {
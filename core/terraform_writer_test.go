@@ -68,6 +68,60 @@ func TestGenerateHclRepoFiles(t *testing.T) {
 	}
 }
 
+func TestGenerateHclFiles(t *testing.T) {
+	t.Parallel()
+
+	config := core.NewConfig()
+	config.AppendRepo(GetFullConfig(1))
+	config.AppendTeam(GetFullTeamConfig(1))
+	config.AppendTeam(GetFullTeamConfig(2))
+	config.Org = GetFullOrgConfig()
+	config.OrgRulesets = GetFullOrgRulesetsConfig()
+
+	expectedFiles := map[string]string{
+		// Declared teams are referenced
+		"repo.repo1.tf":   "repo1.with-teams.full",
+		"team.team1.tf":   "team1.full",
+		"team.team2.tf":   "team2.full",
+		"org.tf":          "org.full",
+		"org-rulesets.tf": "org-rulesets.full",
+		"variables.tf":    "variables.full",
+	}
+
+	files, err := core.GenerateHclFiles(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for fname, goldenfile := range expectedFiles {
+		tffile, exists := files[fname]
+		if !exists {
+			t.Errorf("expected file %s doesn't exist !", fname)
+		} else if err2 := testutils.EnsureFileEqualsGoldenFile(tffile, goldenfile); err2 != nil {
+			t.Errorf("file %s: %v", fname, err2)
+		}
+	}
+
+	if !t.Failed() && len(files) != len(expectedFiles) {
+		t.Errorf("expected %d files, got %d", len(expectedFiles), len(files))
+	}
+}
+
+func TestGenerateHclImportFile(t *testing.T) {
+	t.Parallel()
+
+	resources, err := core.GenerateRepoResources([]*core.GhRepoConfig{GetFullConfig(1), GetFullConfig(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resources = append(resources, &core.TerraformResource{Address: "github_branch_protection.unknown-id"})
+
+	if err = testutils.EnsureFileEqualsGoldenFile(core.GenerateHclImportFile(resources), "imports.full"); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestWriteTerraformFiles(t *testing.T) {
 	t.Parallel()
 
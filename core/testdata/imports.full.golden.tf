@@ -0,0 +1,254 @@
+import {
+  to = github_repository.repo1
+  id = "repo1"
+}
+
+import {
+  to = github_branch_default.repo1
+  id = "repo1"
+}
+
+import {
+  to = github_branch.repo1-feature-branch1
+  id = "repo1:feature/branch1"
+}
+
+import {
+  to = github_branch.repo1-feature-branch2
+  id = "repo1:feature/branch2:branch2-source-branch1"
+}
+
+import {
+  to = github_branch_protection.repo1-default
+  id = "repo1:master1"
+}
+
+import {
+  to = github_branch_protection.repo1-feature-branch1
+  id = "repo1:feature/branch1"
+}
+
+import {
+  to = github_branch_protection.repo1-feature-branch2
+  id = "repo1:feature/branch2"
+}
+
+import {
+  to = github_branch_protection.repo1-a-pattern1
+  id = "repo1:a-pattern1"
+}
+
+import {
+  to = github_branch_protection.repo1-forbidden-pattern1
+  id = "repo1:forbidden-pattern1"
+}
+
+import {
+  to = github_repository_environment.repo1-environment1
+  id = "repo1:environment1"
+}
+
+import {
+  to = github_repository_environment.repo1-protected-environment1
+  id = "repo1:protected-environment1"
+}
+
+import {
+  to = github_actions_repository_permissions.repo1
+  id = "repo1"
+}
+
+import {
+  to = github_workflow_repository_permissions.repo1
+  id = "repo1"
+}
+
+import {
+  to = github_actions_variable.repo1-SHARED_VARIABLE
+  id = "repo1:SHARED_VARIABLE"
+}
+
+import {
+  to = github_actions_variable.repo1-VARIABLE1
+  id = "repo1:VARIABLE1"
+}
+
+import {
+  to = github_actions_environment_variable.repo1-environment1-ENV_VARIABLE
+  id = "repo1:environment1:ENV_VARIABLE"
+}
+
+import {
+  to = github_issue_labels.repo1
+  id = "repo1"
+}
+
+import {
+  to = github_repository_file.repo1-SECURITY-md
+  id = "repo1/SECURITY.md"
+}
+
+import {
+  to = github_repository_file.repo1-docs-file1-md
+  id = "repo1/docs/file1.md:master1"
+}
+
+import {
+  to = github_repository_dependabot_security_updates.repo1
+  id = "repo1"
+}
+
+import {
+  to = github_repository_custom_property.repo1-property1
+  id = "repo1:property1"
+}
+
+import {
+  to = github_repository_custom_property.repo1-topics
+  id = "repo1:topics"
+}
+
+import {
+  to = github_team_repository.repo1-shared-team
+  id = "shared-team:repo1"
+}
+
+import {
+  to = github_team_repository.repo1-team1
+  id = "team1:repo1"
+}
+
+import {
+  to = github_repository_collaborator.repo1-user1
+  id = "repo1:user1"
+}
+
+import {
+  to = github_repository.repo2
+  id = "repo2"
+}
+
+import {
+  to = github_branch_default.repo2
+  id = "repo2"
+}
+
+import {
+  to = github_branch.repo2-feature-branch2
+  id = "repo2:feature/branch2"
+}
+
+import {
+  to = github_branch.repo2-feature-branch3
+  id = "repo2:feature/branch3:branch3-source-branch2"
+}
+
+import {
+  to = github_branch_protection.repo2-default
+  id = "repo2:master2"
+}
+
+import {
+  to = github_branch_protection.repo2-feature-branch2
+  id = "repo2:feature/branch2"
+}
+
+import {
+  to = github_branch_protection.repo2-feature-branch3
+  id = "repo2:feature/branch3"
+}
+
+import {
+  to = github_branch_protection.repo2-a-pattern2
+  id = "repo2:a-pattern2"
+}
+
+import {
+  to = github_branch_protection.repo2-forbidden-pattern2
+  id = "repo2:forbidden-pattern2"
+}
+
+import {
+  to = github_repository_environment.repo2-environment2
+  id = "repo2:environment2"
+}
+
+import {
+  to = github_repository_environment.repo2-protected-environment2
+  id = "repo2:protected-environment2"
+}
+
+import {
+  to = github_actions_repository_permissions.repo2
+  id = "repo2"
+}
+
+import {
+  to = github_workflow_repository_permissions.repo2
+  id = "repo2"
+}
+
+import {
+  to = github_actions_variable.repo2-SHARED_VARIABLE
+  id = "repo2:SHARED_VARIABLE"
+}
+
+import {
+  to = github_actions_variable.repo2-VARIABLE2
+  id = "repo2:VARIABLE2"
+}
+
+import {
+  to = github_actions_environment_variable.repo2-environment2-ENV_VARIABLE
+  id = "repo2:environment2:ENV_VARIABLE"
+}
+
+import {
+  to = github_issue_label.repo2-label2
+  id = "repo2:label2"
+}
+
+import {
+  to = github_issue_label.repo2-bug
+  id = "repo2:bug"
+}
+
+import {
+  to = github_repository_file.repo2-SECURITY-md
+  id = "repo2/SECURITY.md"
+}
+
+import {
+  to = github_repository_file.repo2-docs-file2-md
+  id = "repo2/docs/file2.md:master2"
+}
+
+import {
+  to = github_repository_dependabot_security_updates.repo2
+  id = "repo2"
+}
+
+import {
+  to = github_repository_custom_property.repo2-property2
+  id = "repo2:property2"
+}
+
+import {
+  to = github_repository_custom_property.repo2-topics
+  id = "repo2:topics"
+}
+
+import {
+  to = github_team_repository.repo2-shared-team
+  id = "shared-team:repo2"
+}
+
+import {
+  to = github_team_repository.repo2-team2
+  id = "team2:repo2"
+}
+
+import {
+  to = github_repository_collaborator.repo2-user2
+  id = "repo2:user2"
+}
@@ -0,0 +1,91 @@
+resource "github_organization_ruleset" "org-org-ruleset1" {
+  name        = "org-ruleset1"
+  target      = "branch"
+  enforcement = "active"
+
+  conditions {
+    ref_name {
+      include = ["~DEFAULT_BRANCH"]
+      exclude = ["release/v1"]
+    }
+    repository_id = [github_repository.repo1.repo_id]
+  }
+
+  bypass_actors {
+    actor_id    = github_team.team1.id
+    actor_type  = "Team"
+    bypass_mode = "pull_request"
+  }
+
+  bypass_actors {
+    actor_id    = 5
+    actor_type  = "RepositoryRole"
+    bypass_mode = "always"
+  }
+
+  rules {
+    creation                = false
+    update                  = false
+    deletion                = true
+    non_fast_forward        = true
+    required_linear_history = false
+    required_signatures     = true
+
+    pull_request {
+      dismiss_stale_reviews_on_push     = true
+      require_code_owner_review         = false
+      require_last_push_approval        = false
+      required_approving_review_count   = 1
+      required_review_thread_resolution = true
+    }
+
+    required_status_checks {
+      required_check {
+        context = "ruleset-context1"
+      }
+      strict_required_status_checks_policy = false
+    }
+  }
+}
+
+resource "github_organization_ruleset" "org-org-ruleset2" {
+  name        = "org-ruleset2"
+  target      = "tag"
+  enforcement = "active"
+
+  conditions {
+    ref_name {
+      include = ["~ALL"]
+      exclude = []
+    }
+    repository_name {
+      include = ["service-*"]
+      exclude = ["service-legacy"]
+    }
+  }
+
+  rules {
+    deletion = true
+  }
+}
+
+resource "github_organization_ruleset" "org-org-ruleset3" {
+  name        = "org-ruleset3"
+  target      = "branch"
+  enforcement = "active"
+
+  conditions {
+    ref_name {
+      include = []
+      exclude = []
+    }
+    repository_name {
+      include = ["~ALL"]
+      exclude = []
+    }
+  }
+
+  rules {
+    required_signatures = true
+  }
+}
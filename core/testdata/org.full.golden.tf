@@ -0,0 +1,31 @@
+resource "github_organization_settings" "org" {
+  billing_email    = "billing@example.com"
+  name             = "An organization"
+  description      = "An organization description"
+  company          = "A company"
+  blog             = "https://example.com"
+  email            = "contact@example.com"
+  twitter_username = "an-org"
+  location         = "Somewhere"
+
+  default_repository_permission = "read"
+  web_commit_signoff_required   = true
+  has_organization_projects     = true
+  has_repository_projects       = false
+
+  members_can_create_repositories          = true
+  members_can_create_public_repositories   = false
+  members_can_create_private_repositories  = true
+  members_can_create_internal_repositories = false
+  members_can_create_pages                 = true
+  members_can_create_public_pages          = false
+  members_can_create_private_pages         = true
+  members_can_fork_private_repositories    = false
+
+  advanced_security_enabled_for_new_repositories               = false
+  dependabot_alerts_enabled_for_new_repositories               = true
+  dependabot_security_updates_enabled_for_new_repositories     = true
+  dependency_graph_enabled_for_new_repositories                = true
+  secret_scanning_enabled_for_new_repositories                 = true
+  secret_scanning_push_protection_enabled_for_new_repositories = false
+}
@@ -0,0 +1,686 @@
+resource "github_repository" "repo1" {
+  name      = "repo1"
+  auto_init = false
+
+  visibility  = "visibility1"
+  description = "a description1"
+
+  template {
+    owner                = "owner1"
+    repository           = "repository1"
+    include_all_branches = false
+  }
+
+  topics       = ["topic2", "topic3"]
+  homepage_url = "http://localhost/1"
+
+  pages {
+    cname = "my.domain1"
+    source {
+      branch = "branch1"
+      path   = "path1"
+    }
+  }
+
+  has_issues    = false
+  has_projects  = false
+  has_wiki      = false
+  has_downloads = false
+
+  allow_merge_commit     = false
+  allow_rebase_merge     = false
+  allow_squash_merge     = false
+  allow_auto_merge       = false
+  delete_branch_on_merge = false
+
+  merge_commit_title          = "aMergeCommitTitle1"
+  merge_commit_message        = "aMergeCommitMessage1"
+  squash_merge_commit_title   = "aSquashMergeCommitTitle1"
+  squash_merge_commit_message = "aSquashMergeCommitMessage1"
+
+  vulnerability_alerts = true
+
+  archived           = false
+  archive_on_destroy = true
+
+  is_template        = false
+  gitignore_template = "gitignore-tpl-name1"
+  license_template   = "license-tpl-name1"
+
+  allow_update_branch = false
+
+  ignore_vulnerability_alerts_during_read = true
+
+  security_and_analysis {
+    advanced_security {
+      status = "enabled"
+    }
+    secret_scanning {
+      status = "enabled"
+    }
+    secret_scanning_push_protection {
+      status = "enabled"
+    }
+  }
+}
+
+resource "github_branch_default" "repo1" {
+  repository = github_repository.repo1.name
+  branch     = "master1"
+}
+
+resource "github_branch" "repo1-feature-branch1" {
+  repository = github_repository.repo1.name
+  branch     = "feature/branch1"
+
+  lifecycle {
+    ignore_changes = [source_branch]
+  }
+}
+
+resource "github_branch" "repo1-feature-branch2" {
+  repository    = github_repository.repo1.name
+  branch        = "feature/branch2"
+  source_branch = "branch2-source-branch1"
+  source_sha    = "branch2-source-sha1"
+}
+
+resource "github_branch_protection" "repo1-default" {
+  repository_id                   = github_repository.repo1.node_id
+  pattern                         = github_branch_default.repo1.branch
+  enforce_admins                  = false
+  allows_deletions                = false
+  allows_force_pushes             = false
+  push_restrictions               = ["default-branch-pushRestriction1"]
+  required_linear_history         = false
+  require_signed_commits          = false
+  require_conversation_resolution = false
+
+  required_status_checks {
+    strict   = false
+    contexts = ["default-branch-context1"]
+  }
+
+  required_pull_request_reviews {
+    dismiss_stale_reviews           = false
+    restrict_dismissals             = false
+    dismissal_restrictions          = ["default-branch-dismissalRestriction1"]
+    require_code_owner_reviews      = false
+    required_approving_review_count = 4
+    pull_request_bypassers          = ["/default-branch-bypasser1"]
+  }
+}
+
+resource "github_branch_protection" "repo1-feature-branch1" {
+  repository_id                   = github_repository.repo1.node_id
+  pattern                         = "feature/branch1"
+  enforce_admins                  = true
+  allows_deletions                = true
+  allows_force_pushes             = true
+  push_restrictions               = ["branch1-pushRestriction1"]
+  required_linear_history         = true
+  require_signed_commits          = true
+  require_conversation_resolution = true
+
+  required_status_checks {
+    strict   = true
+    contexts = ["branch1-context1"]
+  }
+
+  required_pull_request_reviews {
+    dismiss_stale_reviews           = true
+    restrict_dismissals             = true
+    dismissal_restrictions          = ["branch1-dismissalRestriction1"]
+    require_code_owner_reviews      = true
+    required_approving_review_count = 5
+    pull_request_bypassers          = ["/branch1-bypasser1"]
+  }
+}
+
+resource "github_branch_protection" "repo1-feature-branch2" {
+  repository_id                   = github_repository.repo1.node_id
+  pattern                         = "feature/branch2"
+  enforce_admins                  = false
+  allows_deletions                = false
+  allows_force_pushes             = false
+  push_restrictions               = ["branch2-pushRestriction1"]
+  required_linear_history         = false
+  require_signed_commits          = false
+  require_conversation_resolution = false
+
+  required_status_checks {
+    strict   = false
+    contexts = ["branch2-context1"]
+  }
+
+  required_pull_request_reviews {
+    dismiss_stale_reviews           = false
+    restrict_dismissals             = false
+    dismissal_restrictions          = ["branch2-dismissalRestriction1"]
+    require_code_owner_reviews      = false
+    required_approving_review_count = 6
+    pull_request_bypassers          = ["/branch2-bypasser1"]
+  }
+}
+
+resource "github_branch_protection" "repo1-a-pattern1" {
+  repository_id                   = github_repository.repo1.node_id
+  pattern                         = "a-pattern1"
+  enforce_admins                  = true
+  allows_deletions                = true
+  allows_force_pushes             = true
+  push_restrictions               = ["branch-protection-pushRestriction1"]
+  required_linear_history         = true
+  require_signed_commits          = true
+  require_conversation_resolution = true
+
+  required_status_checks {
+    strict   = true
+    contexts = ["branch-protection-context1"]
+  }
+
+  required_pull_request_reviews {
+    dismiss_stale_reviews           = true
+    restrict_dismissals             = true
+    dismissal_restrictions          = ["branch-protection-dismissalRestriction1"]
+    require_code_owner_reviews      = true
+    required_approving_review_count = 0
+    pull_request_bypassers          = ["/branch-protection-bypasser1"]
+  }
+}
+
+resource "github_branch_protection" "repo1-forbidden-pattern1" {
+  repository_id       = github_repository.repo1.node_id
+  pattern             = "forbidden-pattern1"
+  allows_deletions    = false
+  allows_force_pushes = false
+  push_restrictions   = []
+  lock_branch         = true
+  blocks_creations    = true
+}
+
+resource "github_repository_ruleset" "repo1-ruleset1" {
+  name        = "ruleset1"
+  repository  = github_repository.repo1.name
+  target      = "branch"
+  enforcement = "active"
+
+  conditions {
+    ref_name {
+      include = ["~DEFAULT_BRANCH"]
+      exclude = ["release/v1"]
+    }
+  }
+
+  bypass_actors {
+    actor_id    = github_team.team1.id
+    actor_type  = "Team"
+    bypass_mode = "pull_request"
+  }
+
+  bypass_actors {
+    actor_id    = 5
+    actor_type  = "RepositoryRole"
+    bypass_mode = "always"
+  }
+
+  rules {
+    creation                = false
+    update                  = false
+    deletion                = true
+    non_fast_forward        = true
+    required_linear_history = false
+    required_signatures     = true
+
+    pull_request {
+      dismiss_stale_reviews_on_push     = true
+      require_code_owner_review         = false
+      require_last_push_approval        = false
+      required_approving_review_count   = 1
+      required_review_thread_resolution = true
+    }
+
+    required_status_checks {
+      required_check {
+        context = "ruleset-context1"
+      }
+      strict_required_status_checks_policy = false
+    }
+  }
+}
+
+resource "github_repository_environment" "repo1-environment1" {
+  environment         = "environment1"
+  repository          = github_repository.repo1.name
+  wait_timer          = 10
+  prevent_self_review = false
+
+  reviewers {
+    users = [1001]
+    teams = [github_team.team1.id, 41]
+  }
+
+  deployment_branch_policy {
+    protected_branches     = false
+    custom_branch_policies = true
+  }
+}
+
+resource "github_repository_environment" "repo1-protected-environment1" {
+  environment = "protected-environment1"
+  repository  = github_repository.repo1.name
+
+  deployment_branch_policy {
+    protected_branches     = true
+    custom_branch_policies = false
+  }
+}
+
+resource "github_repository_environment_deployment_policy" "repo1-environment1-release-v1" {
+  repository     = github_repository.repo1.name
+  environment    = github_repository_environment.repo1-environment1.environment
+  branch_pattern = "release/v1"
+}
+
+resource "github_repository_environment_deployment_policy" "repo1-environment1-main" {
+  repository     = github_repository.repo1.name
+  environment    = github_repository_environment.repo1-environment1.environment
+  branch_pattern = "main"
+}
+
+resource "github_actions_repository_permissions" "repo1" {
+  repository      = github_repository.repo1.name
+  enabled         = true
+  allowed_actions = "selected"
+
+  allowed_actions_config {
+    github_owned_allowed = false
+    verified_allowed     = true
+    patterns_allowed     = ["an-org/action1@*"]
+  }
+}
+
+resource "github_workflow_repository_permissions" "repo1" {
+  repository                       = github_repository.repo1.name
+  default_workflow_permissions     = "read"
+  can_approve_pull_request_reviews = false
+}
+
+resource "github_actions_variable" "repo1-SHARED_VARIABLE" {
+  repository    = github_repository.repo1.name
+  variable_name = "SHARED_VARIABLE"
+  value         = "shared-value1"
+}
+
+resource "github_actions_variable" "repo1-VARIABLE1" {
+  repository    = github_repository.repo1.name
+  variable_name = "VARIABLE1"
+  value         = "value1"
+}
+
+resource "github_actions_secret" "repo1-SECRET1" {
+  repository      = github_repository.repo1.name
+  secret_name     = "SECRET1"
+  plaintext_value = var.repo1-SECRET1
+}
+
+resource "github_actions_secret" "repo1-SHARED_SECRET" {
+  repository      = github_repository.repo1.name
+  secret_name     = "SHARED_SECRET"
+  encrypted_value = var.shared-secret
+}
+
+resource "github_actions_environment_variable" "repo1-environment1-ENV_VARIABLE" {
+  repository    = github_repository.repo1.name
+  environment   = github_repository_environment.repo1-environment1.environment
+  variable_name = "ENV_VARIABLE"
+  value         = "env-value1"
+}
+
+resource "github_actions_environment_secret" "repo1-environment1-ENV_SECRET" {
+  repository      = github_repository.repo1.name
+  environment     = github_repository_environment.repo1-environment1.environment
+  secret_name     = "ENV_SECRET"
+  plaintext_value = var.repo1-environment1-ENV_SECRET
+}
+
+resource "github_actions_environment_secret" "repo1-environment1-ENV_SHARED_SECRET" {
+  repository      = github_repository.repo1.name
+  environment     = github_repository_environment.repo1-environment1.environment
+  secret_name     = "ENV_SHARED_SECRET"
+  plaintext_value = var.env-shared-secret
+}
+
+resource "github_repository_webhook" "repo1-ci1" {
+  repository = github_repository.repo1.name
+  active     = true
+  events     = ["push", "event1"]
+
+  configuration {
+    url          = "https://ci1.example.com/hook"
+    content_type = "json"
+    insecure_ssl = false
+    secret       = var.webhook-secret1
+  }
+}
+
+resource "github_repository_webhook" "repo1-webhook-9551d00537a3" {
+  repository = github_repository.repo1.name
+  events     = ["release"]
+
+  configuration {
+    url = "https://chat.example.com/hook"
+  }
+}
+
+resource "github_issue_labels" "repo1" {
+  repository = github_repository.repo1.name
+
+  label {
+    name        = "label1"
+    color       = "000001"
+    description = "a label description1"
+  }
+
+  label {
+    name  = "bug"
+    color = "d73a4a"
+  }
+}
+
+resource "github_repository_file" "repo1-SECURITY-md" {
+  repository = github_repository.repo1.name
+  file       = "SECURITY.md"
+  content    = file("${path.module}/../files/SECURITY.md")
+}
+
+resource "github_repository_file" "repo1-docs-file1-md" {
+  repository          = github_repository.repo1.name
+  file                = "docs/file1.md"
+  content             = file("${path.module}/../files/file1.md")
+  branch              = github_branch_default.repo1.branch
+  commit_message      = "a commit message1"
+  overwrite_on_create = false
+
+  lifecycle {
+    ignore_changes = [content, commit_message]
+  }
+}
+
+resource "github_repository_dependabot_security_updates" "repo1" {
+  repository = github_repository.repo1.name
+  enabled    = false
+}
+
+resource "terraform_data" "repo1-private-vulnerability-reporting" {
+  triggers_replace = [github_repository.repo1.full_name, false]
+
+  provisioner "local-exec" {
+    command = "gh api --method DELETE repos/${github_repository.repo1.full_name}/private-vulnerability-reporting"
+  }
+
+  provisioner "local-exec" {
+    when    = destroy
+    command = "gh api --method DELETE repos/${self.triggers_replace[0]}/private-vulnerability-reporting"
+  }
+}
+
+resource "terraform_data" "repo1-code-scanning" {
+  triggers_replace = [github_repository.repo1.full_name, "state=configured", "query_suite=default", "languages[]=go"]
+
+  provisioner "local-exec" {
+    command = "gh api --method PATCH repos/${github_repository.repo1.full_name}/code-scanning/default-setup -f 'state=configured' -f 'query_suite=default' -f 'languages[]=go'"
+  }
+
+  provisioner "local-exec" {
+    when    = destroy
+    command = "gh api --method PATCH repos/${self.triggers_replace[0]}/code-scanning/default-setup -f 'state=not-configured'"
+  }
+}
+
+resource "github_repository_deploy_key" "repo1-deploy-key1" {
+  repository = github_repository.repo1.name
+  title      = "deploy-key1"
+  key        = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMkep8EAvhF//MDLWxu14eveA+xy2SbuAusenR2X7Kfb deploy-key1@example.com"
+  read_only  = true
+}
+
+resource "github_repository_deploy_key" "repo1-shared-deploy-key" {
+  repository = github_repository.repo1.name
+  title      = "shared-deploy-key"
+  key        = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMkep8EAvhF//MDLWxu14eveA+xy2SbuAusenR2X7Kfb shared@example.com"
+}
+
+resource "github_repository_autolink_reference" "repo1-TICKET1-" {
+  repository          = github_repository.repo1.name
+  key_prefix          = "TICKET1-"
+  target_url_template = "https://tickets1.example.com/<num>"
+  is_alphanumeric     = false
+}
+
+resource "github_repository_custom_property" "repo1-property1" {
+  repository     = github_repository.repo1.name
+  property_name  = "property1"
+  property_type  = "string"
+  property_value = ["value1"]
+}
+
+resource "github_repository_custom_property" "repo1-topics" {
+  repository     = github_repository.repo1.name
+  property_name  = "topics"
+  property_type  = "multi_select"
+  property_value = ["topic1", "topic2"]
+}
+
+resource "github_team_repository" "repo1-shared-team" {
+  team_id    = "shared-team"
+  repository = github_repository.repo1.name
+  permission = "maintain"
+}
+
+resource "github_team_repository" "repo1-team1" {
+  team_id    = github_team.team1.id
+  repository = github_repository.repo1.name
+  permission = "push"
+}
+
+resource "github_repository_collaborator" "repo1-user1" {
+  repository = github_repository.repo1.name
+  username   = "user1"
+  permission = "pull"
+}
+
+moved {
+  from = github_repository.old-repo1
+  to   = github_repository.repo1
+}
+
+moved {
+  from = github_branch_default.old-repo1
+  to   = github_branch_default.repo1
+}
+
+moved {
+  from = github_branch.old-repo1-feature-branch1
+  to   = github_branch.repo1-feature-branch1
+}
+
+moved {
+  from = github_branch.old-repo1-feature-branch2
+  to   = github_branch.repo1-feature-branch2
+}
+
+moved {
+  from = github_branch_protection.old-repo1-default
+  to   = github_branch_protection.repo1-default
+}
+
+moved {
+  from = github_branch_protection.old-repo1-feature-branch1
+  to   = github_branch_protection.repo1-feature-branch1
+}
+
+moved {
+  from = github_branch_protection.old-repo1-feature-branch2
+  to   = github_branch_protection.repo1-feature-branch2
+}
+
+moved {
+  from = github_branch_protection.old-repo1-a-pattern1
+  to   = github_branch_protection.repo1-a-pattern1
+}
+
+moved {
+  from = github_branch_protection.old-repo1-forbidden-pattern1
+  to   = github_branch_protection.repo1-forbidden-pattern1
+}
+
+moved {
+  from = github_repository_ruleset.old-repo1-ruleset1
+  to   = github_repository_ruleset.repo1-ruleset1
+}
+
+moved {
+  from = github_repository_environment.old-repo1-environment1
+  to   = github_repository_environment.repo1-environment1
+}
+
+moved {
+  from = github_repository_environment.old-repo1-protected-environment1
+  to   = github_repository_environment.repo1-protected-environment1
+}
+
+moved {
+  from = github_repository_environment_deployment_policy.old-repo1-environment1-release-v1
+  to   = github_repository_environment_deployment_policy.repo1-environment1-release-v1
+}
+
+moved {
+  from = github_repository_environment_deployment_policy.old-repo1-environment1-main
+  to   = github_repository_environment_deployment_policy.repo1-environment1-main
+}
+
+moved {
+  from = github_actions_repository_permissions.old-repo1
+  to   = github_actions_repository_permissions.repo1
+}
+
+moved {
+  from = github_workflow_repository_permissions.old-repo1
+  to   = github_workflow_repository_permissions.repo1
+}
+
+moved {
+  from = github_actions_variable.old-repo1-SHARED_VARIABLE
+  to   = github_actions_variable.repo1-SHARED_VARIABLE
+}
+
+moved {
+  from = github_actions_variable.old-repo1-VARIABLE1
+  to   = github_actions_variable.repo1-VARIABLE1
+}
+
+moved {
+  from = github_actions_secret.old-repo1-SECRET1
+  to   = github_actions_secret.repo1-SECRET1
+}
+
+moved {
+  from = github_actions_secret.old-repo1-SHARED_SECRET
+  to   = github_actions_secret.repo1-SHARED_SECRET
+}
+
+moved {
+  from = github_actions_environment_variable.old-repo1-environment1-ENV_VARIABLE
+  to   = github_actions_environment_variable.repo1-environment1-ENV_VARIABLE
+}
+
+moved {
+  from = github_actions_environment_secret.old-repo1-environment1-ENV_SECRET
+  to   = github_actions_environment_secret.repo1-environment1-ENV_SECRET
+}
+
+moved {
+  from = github_actions_environment_secret.old-repo1-environment1-ENV_SHARED_SECRET
+  to   = github_actions_environment_secret.repo1-environment1-ENV_SHARED_SECRET
+}
+
+moved {
+  from = github_repository_webhook.old-repo1-ci1
+  to   = github_repository_webhook.repo1-ci1
+}
+
+moved {
+  from = github_repository_webhook.old-repo1-webhook-9551d00537a3
+  to   = github_repository_webhook.repo1-webhook-9551d00537a3
+}
+
+moved {
+  from = github_issue_labels.old-repo1
+  to   = github_issue_labels.repo1
+}
+
+moved {
+  from = github_repository_file.old-repo1-SECURITY-md
+  to   = github_repository_file.repo1-SECURITY-md
+}
+
+moved {
+  from = github_repository_file.old-repo1-docs-file1-md
+  to   = github_repository_file.repo1-docs-file1-md
+}
+
+moved {
+  from = github_repository_dependabot_security_updates.old-repo1
+  to   = github_repository_dependabot_security_updates.repo1
+}
+
+moved {
+  from = terraform_data.old-repo1-private-vulnerability-reporting
+  to   = terraform_data.repo1-private-vulnerability-reporting
+}
+
+moved {
+  from = terraform_data.old-repo1-code-scanning
+  to   = terraform_data.repo1-code-scanning
+}
+
+moved {
+  from = github_repository_deploy_key.old-repo1-deploy-key1
+  to   = github_repository_deploy_key.repo1-deploy-key1
+}
+
+moved {
+  from = github_repository_deploy_key.old-repo1-shared-deploy-key
+  to   = github_repository_deploy_key.repo1-shared-deploy-key
+}
+
+moved {
+  from = github_repository_autolink_reference.old-repo1-TICKET1-
+  to   = github_repository_autolink_reference.repo1-TICKET1-
+}
+
+moved {
+  from = github_repository_custom_property.old-repo1-property1
+  to   = github_repository_custom_property.repo1-property1
+}
+
+moved {
+  from = github_repository_custom_property.old-repo1-topics
+  to   = github_repository_custom_property.repo1-topics
+}
+
+moved {
+  from = github_team_repository.old-repo1-shared-team
+  to   = github_team_repository.repo1-shared-team
+}
+
+moved {
+  from = github_team_repository.old-repo1-team1
+  to   = github_team_repository.repo1-team1
+}
+
+moved {
+  from = github_repository_collaborator.old-repo1-user1
+  to   = github_repository_collaborator.repo1-user1
+}
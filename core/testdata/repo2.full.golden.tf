@@ -6,14 +6,16 @@ resource "github_repository" "repo2" {
   description = "a description2"
 
   template {
-    owner      = "owner2"
-    repository = "repository2"
+    owner                = "owner2"
+    repository           = "repository2"
+    include_all_branches = true
   }
 
   topics       = ["topic4", "topic5"]
   homepage_url = "http://localhost/2"
 
   pages {
+    cname = "my.domain2"
     source {
       branch = "branch2"
       path   = "path2"
@@ -40,6 +42,26 @@ resource "github_repository" "repo2" {
 
   archived           = true
   archive_on_destroy = false
+
+  is_template        = true
+  gitignore_template = "gitignore-tpl-name2"
+  license_template   = "license-tpl-name2"
+
+  allow_update_branch = true
+
+  ignore_vulnerability_alerts_during_read = false
+
+  security_and_analysis {
+    advanced_security {
+      status = "disabled"
+    }
+    secret_scanning {
+      status = "disabled"
+    }
+    secret_scanning_push_protection {
+      status = "disabled"
+    }
+  }
 }
 
 resource "github_branch_default" "repo2" {
@@ -64,14 +86,15 @@ resource "github_branch" "repo2-feature-branch3" {
 }
 
 resource "github_branch_protection" "repo2-default" {
-  repository_id           = github_repository.repo2.node_id
-  pattern                 = github_branch_default.repo2.branch
-  enforce_admins          = true
-  allows_deletions        = true
-  allows_force_pushes     = true
-  push_restrictions       = ["default-branch-pushRestriction2"]
-  required_linear_history = true
-  require_signed_commits  = true
+  repository_id                   = github_repository.repo2.node_id
+  pattern                         = github_branch_default.repo2.branch
+  enforce_admins                  = true
+  allows_deletions                = true
+  allows_force_pushes             = true
+  push_restrictions               = ["default-branch-pushRestriction2"]
+  required_linear_history         = true
+  require_signed_commits          = true
+  require_conversation_resolution = true
 
   required_status_checks {
     strict   = true
@@ -84,18 +107,20 @@ resource "github_branch_protection" "repo2-default" {
     dismissal_restrictions          = ["default-branch-dismissalRestriction2"]
     require_code_owner_reviews      = true
     required_approving_review_count = 1
+    pull_request_bypassers          = ["/default-branch-bypasser2"]
   }
 }
 
 resource "github_branch_protection" "repo2-feature-branch2" {
-  repository_id           = github_repository.repo2.node_id
-  pattern                 = "feature/branch2"
-  enforce_admins          = false
-  allows_deletions        = false
-  allows_force_pushes     = false
-  push_restrictions       = ["branch2-pushRestriction2"]
-  required_linear_history = false
-  require_signed_commits  = false
+  repository_id                   = github_repository.repo2.node_id
+  pattern                         = "feature/branch2"
+  enforce_admins                  = false
+  allows_deletions                = false
+  allows_force_pushes             = false
+  push_restrictions               = ["branch2-pushRestriction2"]
+  required_linear_history         = false
+  require_signed_commits          = false
+  require_conversation_resolution = false
 
   required_status_checks {
     strict   = false
@@ -108,18 +133,20 @@ resource "github_branch_protection" "repo2-feature-branch2" {
     dismissal_restrictions          = ["branch2-dismissalRestriction2"]
     require_code_owner_reviews      = false
     required_approving_review_count = 2
+    pull_request_bypassers          = ["/branch2-bypasser2"]
   }
 }
 
 resource "github_branch_protection" "repo2-feature-branch3" {
-  repository_id           = github_repository.repo2.node_id
-  pattern                 = "feature/branch3"
-  enforce_admins          = true
-  allows_deletions        = true
-  allows_force_pushes     = true
-  push_restrictions       = ["branch3-pushRestriction2"]
-  required_linear_history = true
-  require_signed_commits  = true
+  repository_id                   = github_repository.repo2.node_id
+  pattern                         = "feature/branch3"
+  enforce_admins                  = true
+  allows_deletions                = true
+  allows_force_pushes             = true
+  push_restrictions               = ["branch3-pushRestriction2"]
+  required_linear_history         = true
+  require_signed_commits          = true
+  require_conversation_resolution = true
 
   required_status_checks {
     strict   = true
@@ -132,18 +159,20 @@ resource "github_branch_protection" "repo2-feature-branch3" {
     dismissal_restrictions          = ["branch3-dismissalRestriction2"]
     require_code_owner_reviews      = true
     required_approving_review_count = 3
+    pull_request_bypassers          = ["/branch3-bypasser2"]
   }
 }
 
 resource "github_branch_protection" "repo2-a-pattern2" {
-  repository_id           = github_repository.repo2.node_id
-  pattern                 = "a-pattern2"
-  enforce_admins          = false
-  allows_deletions        = false
-  allows_force_pushes     = false
-  push_restrictions       = ["branch-protection-pushRestriction2"]
-  required_linear_history = false
-  require_signed_commits  = false
+  repository_id                   = github_repository.repo2.node_id
+  pattern                         = "a-pattern2"
+  enforce_admins                  = false
+  allows_deletions                = false
+  allows_force_pushes             = false
+  push_restrictions               = ["branch-protection-pushRestriction2"]
+  required_linear_history         = false
+  require_signed_commits          = false
+  require_conversation_resolution = false
 
   required_status_checks {
     strict   = false
@@ -156,5 +185,494 @@ resource "github_branch_protection" "repo2-a-pattern2" {
     dismissal_restrictions          = ["branch-protection-dismissalRestriction2"]
     require_code_owner_reviews      = false
     required_approving_review_count = 4
+    pull_request_bypassers          = ["/branch-protection-bypasser2"]
+  }
+}
+
+resource "github_branch_protection" "repo2-forbidden-pattern2" {
+  repository_id = github_repository.repo2.node_id
+  pattern       = "forbidden-pattern2"
+}
+
+resource "github_repository_ruleset" "repo2-ruleset2" {
+  name        = "ruleset2"
+  repository  = github_repository.repo2.name
+  target      = "tag"
+  enforcement = "evaluate"
+
+  conditions {
+    ref_name {
+      include = ["~DEFAULT_BRANCH"]
+      exclude = ["release/v2"]
+    }
+  }
+
+  bypass_actors {
+    actor_id    = 5
+    actor_type  = "RepositoryRole"
+    bypass_mode = "always"
+  }
+
+  rules {
+    creation                = true
+    update                  = true
+    deletion                = false
+    non_fast_forward        = false
+    required_linear_history = true
+    required_signatures     = false
+
+    pull_request {
+      dismiss_stale_reviews_on_push     = false
+      require_code_owner_review         = true
+      require_last_push_approval        = true
+      required_approving_review_count   = 5
+      required_review_thread_resolution = false
+    }
+
+    required_status_checks {
+      required_check {
+        context = "ruleset-context2"
+      }
+      strict_required_status_checks_policy = true
+    }
+  }
+}
+
+resource "github_repository_environment" "repo2-environment2" {
+  environment         = "environment2"
+  repository          = github_repository.repo2.name
+  wait_timer          = 20
+  prevent_self_review = true
+
+  reviewers {
+    users = [1002]
+    teams = [42]
+  }
+
+  deployment_branch_policy {
+    protected_branches     = false
+    custom_branch_policies = true
+  }
+}
+
+resource "github_repository_environment" "repo2-protected-environment2" {
+  environment = "protected-environment2"
+  repository  = github_repository.repo2.name
+
+  deployment_branch_policy {
+    protected_branches     = true
+    custom_branch_policies = false
+  }
+}
+
+resource "github_repository_environment_deployment_policy" "repo2-environment2-release-v2" {
+  repository     = github_repository.repo2.name
+  environment    = github_repository_environment.repo2-environment2.environment
+  branch_pattern = "release/v2"
+}
+
+resource "github_repository_environment_deployment_policy" "repo2-environment2-main" {
+  repository     = github_repository.repo2.name
+  environment    = github_repository_environment.repo2-environment2.environment
+  branch_pattern = "main"
+}
+
+resource "github_actions_repository_permissions" "repo2" {
+  repository      = github_repository.repo2.name
+  enabled         = true
+  allowed_actions = "selected"
+
+  allowed_actions_config {
+    github_owned_allowed = true
+    verified_allowed     = false
+    patterns_allowed     = ["an-org/action2@*"]
+  }
+}
+
+resource "github_workflow_repository_permissions" "repo2" {
+  repository                       = github_repository.repo2.name
+  default_workflow_permissions     = "write"
+  can_approve_pull_request_reviews = true
+}
+
+resource "github_actions_variable" "repo2-SHARED_VARIABLE" {
+  repository    = github_repository.repo2.name
+  variable_name = "SHARED_VARIABLE"
+  value         = "shared-value2"
+}
+
+resource "github_actions_variable" "repo2-VARIABLE2" {
+  repository    = github_repository.repo2.name
+  variable_name = "VARIABLE2"
+  value         = "value2"
+}
+
+resource "github_actions_secret" "repo2-SECRET2" {
+  repository      = github_repository.repo2.name
+  secret_name     = "SECRET2"
+  plaintext_value = var.repo2-SECRET2
+}
+
+resource "github_actions_secret" "repo2-SHARED_SECRET" {
+  repository      = github_repository.repo2.name
+  secret_name     = "SHARED_SECRET"
+  plaintext_value = var.shared-secret
+}
+
+resource "github_actions_environment_variable" "repo2-environment2-ENV_VARIABLE" {
+  repository    = github_repository.repo2.name
+  environment   = github_repository_environment.repo2-environment2.environment
+  variable_name = "ENV_VARIABLE"
+  value         = "env-value2"
+}
+
+resource "github_actions_environment_secret" "repo2-environment2-ENV_SECRET" {
+  repository      = github_repository.repo2.name
+  environment     = github_repository_environment.repo2-environment2.environment
+  secret_name     = "ENV_SECRET"
+  plaintext_value = var.repo2-environment2-ENV_SECRET
+}
+
+resource "github_actions_environment_secret" "repo2-environment2-ENV_SHARED_SECRET" {
+  repository      = github_repository.repo2.name
+  environment     = github_repository_environment.repo2-environment2.environment
+  secret_name     = "ENV_SHARED_SECRET"
+  encrypted_value = var.env-shared-secret
+}
+
+resource "github_repository_webhook" "repo2-ci2" {
+  repository = github_repository.repo2.name
+  active     = false
+  events     = ["push", "event2"]
+
+  configuration {
+    url          = "https://ci2.example.com/hook"
+    content_type = "json"
+    insecure_ssl = true
+    secret       = var.webhook-secret2
+  }
+}
+
+resource "github_repository_webhook" "repo2-webhook-9551d00537a3" {
+  repository = github_repository.repo2.name
+  events     = ["release"]
+
+  configuration {
+    url = "https://chat.example.com/hook"
+  }
+}
+
+resource "github_issue_label" "repo2-label2" {
+  repository  = github_repository.repo2.name
+  name        = "label2"
+  color       = "000002"
+  description = "a label description2"
+}
+
+resource "github_issue_label" "repo2-bug" {
+  repository = github_repository.repo2.name
+  name       = "bug"
+  color      = "d73a4a"
+}
+
+resource "github_repository_file" "repo2-SECURITY-md" {
+  repository = github_repository.repo2.name
+  file       = "SECURITY.md"
+  content    = file("${path.module}/../files/SECURITY.md")
+}
+
+resource "github_repository_file" "repo2-docs-file2-md" {
+  repository          = github_repository.repo2.name
+  file                = "docs/file2.md"
+  content             = file("${path.module}/../files/file2.md")
+  branch              = github_branch_default.repo2.branch
+  commit_message      = "a commit message2"
+  overwrite_on_create = true
+
+  lifecycle {
+    ignore_changes = [content, commit_message]
   }
 }
+
+resource "github_repository_dependabot_security_updates" "repo2" {
+  repository = github_repository.repo2.name
+  enabled    = true
+}
+
+resource "terraform_data" "repo2-private-vulnerability-reporting" {
+  triggers_replace = [github_repository.repo2.full_name, true]
+
+  provisioner "local-exec" {
+    command = "gh api --method PUT repos/${github_repository.repo2.full_name}/private-vulnerability-reporting"
+  }
+
+  provisioner "local-exec" {
+    when    = destroy
+    command = "gh api --method DELETE repos/${self.triggers_replace[0]}/private-vulnerability-reporting"
+  }
+}
+
+resource "terraform_data" "repo2-code-scanning" {
+  triggers_replace = [github_repository.repo2.full_name, "state=not-configured", "query_suite=extended", "languages[]=python"]
+
+  provisioner "local-exec" {
+    command = "gh api --method PATCH repos/${github_repository.repo2.full_name}/code-scanning/default-setup -f 'state=not-configured' -f 'query_suite=extended' -f 'languages[]=python'"
+  }
+
+  provisioner "local-exec" {
+    when    = destroy
+    command = "gh api --method PATCH repos/${self.triggers_replace[0]}/code-scanning/default-setup -f 'state=not-configured'"
+  }
+}
+
+resource "github_repository_deploy_key" "repo2-deploy-key2" {
+  repository = github_repository.repo2.name
+  title      = "deploy-key2"
+  key        = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMkep8EAvhF//MDLWxu14eveA+xy2SbuAusenR2X7Kfb deploy-key2@example.com"
+  read_only  = false
+}
+
+resource "github_repository_deploy_key" "repo2-shared-deploy-key" {
+  repository = github_repository.repo2.name
+  title      = "shared-deploy-key"
+  key        = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMkep8EAvhF//MDLWxu14eveA+xy2SbuAusenR2X7Kfb shared@example.com"
+}
+
+resource "github_repository_autolink_reference" "repo2-TICKET2-" {
+  repository          = github_repository.repo2.name
+  key_prefix          = "TICKET2-"
+  target_url_template = "https://tickets2.example.com/<num>"
+  is_alphanumeric     = true
+}
+
+resource "github_repository_custom_property" "repo2-property2" {
+  repository     = github_repository.repo2.name
+  property_name  = "property2"
+  property_type  = "string"
+  property_value = ["value2"]
+}
+
+resource "github_repository_custom_property" "repo2-topics" {
+  repository     = github_repository.repo2.name
+  property_name  = "topics"
+  property_type  = "multi_select"
+  property_value = ["topic1", "topic2"]
+}
+
+resource "github_team_repository" "repo2-shared-team" {
+  team_id    = "shared-team"
+  repository = github_repository.repo2.name
+  permission = "admin"
+}
+
+resource "github_team_repository" "repo2-team2" {
+  team_id    = "team2"
+  repository = github_repository.repo2.name
+  permission = "triage"
+}
+
+resource "github_repository_collaborator" "repo2-user2" {
+  repository = github_repository.repo2.name
+  username   = "user2"
+  permission = "pull"
+}
+
+moved {
+  from = github_repository.old-repo2
+  to   = github_repository.repo2
+}
+
+moved {
+  from = github_branch_default.old-repo2
+  to   = github_branch_default.repo2
+}
+
+moved {
+  from = github_branch.old-repo2-feature-branch2
+  to   = github_branch.repo2-feature-branch2
+}
+
+moved {
+  from = github_branch.old-repo2-feature-branch3
+  to   = github_branch.repo2-feature-branch3
+}
+
+moved {
+  from = github_branch_protection.old-repo2-default
+  to   = github_branch_protection.repo2-default
+}
+
+moved {
+  from = github_branch_protection.old-repo2-feature-branch2
+  to   = github_branch_protection.repo2-feature-branch2
+}
+
+moved {
+  from = github_branch_protection.old-repo2-feature-branch3
+  to   = github_branch_protection.repo2-feature-branch3
+}
+
+moved {
+  from = github_branch_protection.old-repo2-a-pattern2
+  to   = github_branch_protection.repo2-a-pattern2
+}
+
+moved {
+  from = github_branch_protection.old-repo2-forbidden-pattern2
+  to   = github_branch_protection.repo2-forbidden-pattern2
+}
+
+moved {
+  from = github_repository_ruleset.old-repo2-ruleset2
+  to   = github_repository_ruleset.repo2-ruleset2
+}
+
+moved {
+  from = github_repository_environment.old-repo2-environment2
+  to   = github_repository_environment.repo2-environment2
+}
+
+moved {
+  from = github_repository_environment.old-repo2-protected-environment2
+  to   = github_repository_environment.repo2-protected-environment2
+}
+
+moved {
+  from = github_repository_environment_deployment_policy.old-repo2-environment2-release-v2
+  to   = github_repository_environment_deployment_policy.repo2-environment2-release-v2
+}
+
+moved {
+  from = github_repository_environment_deployment_policy.old-repo2-environment2-main
+  to   = github_repository_environment_deployment_policy.repo2-environment2-main
+}
+
+moved {
+  from = github_actions_repository_permissions.old-repo2
+  to   = github_actions_repository_permissions.repo2
+}
+
+moved {
+  from = github_workflow_repository_permissions.old-repo2
+  to   = github_workflow_repository_permissions.repo2
+}
+
+moved {
+  from = github_actions_variable.old-repo2-SHARED_VARIABLE
+  to   = github_actions_variable.repo2-SHARED_VARIABLE
+}
+
+moved {
+  from = github_actions_variable.old-repo2-VARIABLE2
+  to   = github_actions_variable.repo2-VARIABLE2
+}
+
+moved {
+  from = github_actions_secret.old-repo2-SECRET2
+  to   = github_actions_secret.repo2-SECRET2
+}
+
+moved {
+  from = github_actions_secret.old-repo2-SHARED_SECRET
+  to   = github_actions_secret.repo2-SHARED_SECRET
+}
+
+moved {
+  from = github_actions_environment_variable.old-repo2-environment2-ENV_VARIABLE
+  to   = github_actions_environment_variable.repo2-environment2-ENV_VARIABLE
+}
+
+moved {
+  from = github_actions_environment_secret.old-repo2-environment2-ENV_SECRET
+  to   = github_actions_environment_secret.repo2-environment2-ENV_SECRET
+}
+
+moved {
+  from = github_actions_environment_secret.old-repo2-environment2-ENV_SHARED_SECRET
+  to   = github_actions_environment_secret.repo2-environment2-ENV_SHARED_SECRET
+}
+
+moved {
+  from = github_repository_webhook.old-repo2-ci2
+  to   = github_repository_webhook.repo2-ci2
+}
+
+moved {
+  from = github_repository_webhook.old-repo2-webhook-9551d00537a3
+  to   = github_repository_webhook.repo2-webhook-9551d00537a3
+}
+
+moved {
+  from = github_issue_label.old-repo2-label2
+  to   = github_issue_label.repo2-label2
+}
+
+moved {
+  from = github_issue_label.old-repo2-bug
+  to   = github_issue_label.repo2-bug
+}
+
+moved {
+  from = github_repository_file.old-repo2-SECURITY-md
+  to   = github_repository_file.repo2-SECURITY-md
+}
+
+moved {
+  from = github_repository_file.old-repo2-docs-file2-md
+  to   = github_repository_file.repo2-docs-file2-md
+}
+
+moved {
+  from = github_repository_dependabot_security_updates.old-repo2
+  to   = github_repository_dependabot_security_updates.repo2
+}
+
+moved {
+  from = terraform_data.old-repo2-private-vulnerability-reporting
+  to   = terraform_data.repo2-private-vulnerability-reporting
+}
+
+moved {
+  from = terraform_data.old-repo2-code-scanning
+  to   = terraform_data.repo2-code-scanning
+}
+
+moved {
+  from = github_repository_deploy_key.old-repo2-deploy-key2
+  to   = github_repository_deploy_key.repo2-deploy-key2
+}
+
+moved {
+  from = github_repository_deploy_key.old-repo2-shared-deploy-key
+  to   = github_repository_deploy_key.repo2-shared-deploy-key
+}
+
+moved {
+  from = github_repository_autolink_reference.old-repo2-TICKET2-
+  to   = github_repository_autolink_reference.repo2-TICKET2-
+}
+
+moved {
+  from = github_repository_custom_property.old-repo2-property2
+  to   = github_repository_custom_property.repo2-property2
+}
+
+moved {
+  from = github_repository_custom_property.old-repo2-topics
+  to   = github_repository_custom_property.repo2-topics
+}
+
+moved {
+  from = github_team_repository.old-repo2-shared-team
+  to   = github_team_repository.repo2-shared-team
+}
+
+moved {
+  from = github_team_repository.old-repo2-team2
+  to   = github_team_repository.repo2-team2
+}
+
+moved {
+  from = github_repository_collaborator.old-repo2-user2
+  to   = github_repository_collaborator.repo2-user2
+}
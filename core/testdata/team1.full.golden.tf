@@ -0,0 +1,20 @@
+resource "github_team" "team1" {
+  name           = "team1"
+  description    = "a team description1"
+  privacy        = "closed"
+  parent_team_id = "team0"
+}
+
+resource "github_team_members" "team1" {
+  team_id = github_team.team1.id
+
+  members {
+    username = "maintainer1"
+    role     = "maintainer"
+  }
+
+  members {
+    username = "member1"
+    role     = "member"
+  }
+}
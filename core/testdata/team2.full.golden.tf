@@ -0,0 +1,20 @@
+resource "github_team" "team2" {
+  name           = "team2"
+  description    = "a team description2"
+  privacy        = "closed"
+  parent_team_id = github_team.team1.id
+}
+
+resource "github_team_members" "team2" {
+  team_id = github_team.team2.id
+
+  members {
+    username = "maintainer2"
+    role     = "maintainer"
+  }
+
+  members {
+    username = "member2"
+    role     = "member"
+  }
+}
@@ -0,0 +1,24 @@
+variable "env-shared-secret" {
+  type      = string
+  sensitive = true
+}
+
+variable "repo1-SECRET1" {
+  type      = string
+  sensitive = true
+}
+
+variable "repo1-environment1-ENV_SECRET" {
+  type      = string
+  sensitive = true
+}
+
+variable "shared-secret" {
+  type      = string
+  sensitive = true
+}
+
+variable "webhook-secret1" {
+  type      = string
+  sensitive = true
+}
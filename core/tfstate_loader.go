@@ -0,0 +1,113 @@
+package core
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// TerraformStateResource is a managed resource instance found in a terraform state.
+type TerraformStateResource struct {
+	Type       string
+	Name       string
+	Attributes map[string]interface{}
+}
+
+/** Public **/
+
+// LoadTerraformStateFromFile loads managed resources from either a raw state file (terraform.tfstate, format v4)
+// or the output of 'terraform show -json'.
+func LoadTerraformStateFromFile(filePath string) ([]*TerraformStateResource, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		//nolint:wrapcheck // Expected to return unwrap error
+		return nil, err
+	}
+
+	//nolint:exhaustruct // No need here, simple init
+	state := &tfState{}
+	if err = json.Unmarshal(content, state); err != nil {
+		return nil, FileError(filePath, err)
+	}
+
+	switch {
+	case state.Values != nil:
+		return collectTfShowModuleResources(state.Values.RootModule), nil
+	case state.Resources != nil:
+		return collectTfStateResources(state.Resources), nil
+	}
+
+	return nil, FileError(filePath, ErrUnknownTerraformStateFormat)
+}
+
+/** Private **/
+
+// tfState contains both raw state file and 'terraform show -json' output properties.
+type tfState struct {
+	// Raw state file
+	Resources []*tfStateResource `json:"resources"`
+	// 'terraform show -json' output
+	Values *tfShowValues `json:"values"`
+}
+
+type tfStateResource struct {
+	Mode      string `json:"mode"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	Instances []*struct {
+		Attributes map[string]interface{} `json:"attributes"`
+	} `json:"instances"`
+}
+
+type tfShowValues struct {
+	RootModule *tfShowModule `json:"root_module"`
+}
+
+type tfShowModule struct {
+	Resources []*struct {
+		Mode   string                 `json:"mode"`
+		Type   string                 `json:"type"`
+		Name   string                 `json:"name"`
+		Values map[string]interface{} `json:"values"`
+	} `json:"resources"`
+	ChildModules []*tfShowModule `json:"child_modules"`
+}
+
+func collectTfStateResources(list []*tfStateResource) []*TerraformStateResource {
+	resources := []*TerraformStateResource{}
+
+	for _, res := range list {
+		if res.Mode != "managed" {
+			continue
+		}
+
+		for _, instance := range res.Instances {
+			resources = append(
+				resources,
+				&TerraformStateResource{Type: res.Type, Name: res.Name, Attributes: instance.Attributes},
+			)
+		}
+	}
+
+	return resources
+}
+
+func collectTfShowModuleResources(module *tfShowModule) []*TerraformStateResource {
+	resources := []*TerraformStateResource{}
+	if module == nil {
+		return resources
+	}
+
+	for _, res := range module.Resources {
+		if res.Mode != "managed" {
+			continue
+		}
+
+		resources = append(resources, &TerraformStateResource{Type: res.Type, Name: res.Name, Attributes: res.Values})
+	}
+
+	for _, child := range module.ChildModules {
+		resources = append(resources, collectTfShowModuleResources(child)...)
+	}
+
+	return resources
+}
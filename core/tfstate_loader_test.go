@@ -0,0 +1,81 @@
+package core_test
+
+import (
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+func TestLoadTerraformStateFromFile(t *testing.T) {
+	t.Parallel()
+
+	expected := []*core.TerraformStateResource{
+		{"github_repository", "repo1", map[string]interface{}{"name": "repo1", "archived": false}},
+		{"github_branch_default", "repo1", map[string]interface{}{"repository": "repo1", "branch": "master"}},
+	}
+	cases := map[string]struct {
+		content  string
+		expected []*core.TerraformStateResource
+		error    error
+	}{
+		"State file": {
+			`{"version": 4, "resources": [
+				{"mode": "data", "type": "github_user", "name": "me", "instances": [{"attributes": {"username": "me"}}]},
+				{"mode": "managed", "type": "github_repository", "name": "repo1", "instances": [
+					{"attributes": {"name": "repo1", "archived": false}}
+				]},
+				{"mode": "managed", "type": "github_branch_default", "name": "repo1", "instances": [
+					{"attributes": {"repository": "repo1", "branch": "master"}}
+				]}
+			]}`,
+			expected,
+			nil,
+		},
+		"Show JSON output": {
+			`{"format_version": "1.0", "values": {"root_module": {
+				"resources": [
+					{"mode": "managed", "type": "github_repository", "name": "repo1", "values": {"name": "repo1", "archived": false}}
+				],
+				"child_modules": [{"resources": [
+					{"mode": "data", "type": "github_user", "name": "me", "values": {"username": "me"}},
+					{"mode": "managed", "type": "github_branch_default", "name": "repo1", "values": {"repository": "repo1", "branch": "master"}}
+				]}]
+			}}}`,
+			expected,
+			nil,
+		},
+		"Unknown format": {
+			`{"version": 3}`,
+			nil,
+			core.ErrUnknownTerraformStateFormat,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				root := t.TempDir()
+				if err := os.WriteFile(path.Join(root, "state.json"), []byte(tc.content), 0o600); err != nil {
+					t.Fatal(err)
+				}
+
+				actual, err := core.LoadTerraformStateFromFile(path.Join(root, "state.json"))
+				if tc.error != nil {
+					if !errors.Is(err, tc.error) {
+						t.Errorf("Expected error %q, got %v", tc.error, err)
+					}
+				} else if diff := cmp.Diff(tc.expected, actual); diff != "" {
+					t.Errorf("Resources mismatch (-want +got):\n%s", diff)
+				}
+			},
+		)
+	}
+}
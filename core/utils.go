@@ -1,6 +1,9 @@
 package core
 
-import "sort"
+import (
+	"reflect"
+	"sort"
+)
 
 func MapToSortedList[T any](list map[string]T) []T {
 	_, newList := MapToSortedListWithKeys(list)
@@ -25,3 +28,8 @@ func MapToSortedListWithKeys[T any](list map[string]T) ([]string, []T) {
 
 	return keys, newList
 }
+
+// isEmptyStruct returns true if every property of the struct behind the pointer is a zero value.
+func isEmptyStruct(value interface{}) bool {
+	return reflect.ValueOf(value).Elem().IsZero()
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"slices"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/yoanm/go-tfsig"
+)
+
+/** Public **/
+
+// NewHclVariables returns a file declaring terraform input variables expected by repositories (actions secret values
+// or webhook secrets for instance), or nil if there is none.
+//
+// Variables are declared without default value, so terraform fails if one of them is not provided.
+func NewHclVariables(repoConfigs []*GhRepoConfig, valGen tfsig.ValueGenerator) *hclwrite.File {
+	nameList := []string{}
+
+	for _, repoConfig := range repoConfigs {
+		if repoConfig.Name == nil {
+			continue
+		}
+
+		repoTfId := tfsig.ToTerraformIdentifier(*repoConfig.Name)
+		repoNameList := MapToActionsSecretInputVariableList(repoConfig, repoTfId)
+		repoNameList = append(repoNameList, MapToWebhookSecretInputVariableList(repoConfig)...)
+
+		for _, name := range repoNameList {
+			if !slices.Contains(nameList, name) {
+				nameList = append(nameList, name)
+			}
+		}
+	}
+
+	if len(nameList) == 0 {
+		return nil
+	}
+
+	slices.Sort(nameList)
+
+	hclFile := hclwrite.NewEmptyFile()
+	stringType := "string"
+	sensitive := "true"
+
+	for idx, name := range nameList {
+		if idx > 0 {
+			hclFile.Body().AppendNewline()
+		}
+
+		sig := tfsig.NewSignature("variable", name)
+		tfsig.AppendAttributeIfNotNil(sig, "type", valGen.ToIdent(&stringType))
+		tfsig.AppendAttributeIfNotNil(sig, "sensitive", valGen.ToBool(&sensitive))
+
+		hclFile.Body().AppendBlock(sig.Build())
+	}
+
+	return hclFile
+}
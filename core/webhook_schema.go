@@ -0,0 +1,49 @@
+package core
+
+type GhWebhooksConfig []*GhWebhookConfig
+
+func (to *GhWebhooksConfig) Merge(from *GhWebhooksConfig) {
+	if from == nil {
+		return
+	}
+	// Duplicate every 'from' items to avoid overflow later
+	newItems := make(GhWebhooksConfig, len(*from))
+
+	for k, v := range *from {
+		//nolint:exhaustruct // No need here, it's base structure
+		newItem := &GhWebhookConfig{}
+		newItem.Merge(v)
+		newItems[k] = newItem
+	}
+
+	*to = append(*to, newItems...)
+}
+
+type GhWebhookConfig struct {
+	// Name is optional and only used to compute the terraform identifier, URL is used otherwise
+	Name *string `yaml:"name,omitempty"`
+	//nolint:tagliatelle // yaml templates, not config templates => better to use underscore here
+	ConfigTemplates *[]string `yaml:"_templates,omitempty,flow"`
+	Url             *string   `yaml:"url,omitempty"`
+	ContentType     *string   `yaml:"content-type,omitempty"`
+	Events          *[]string `yaml:"events,omitempty,flow"`
+	Active          *string   `yaml:"active,omitempty"`
+	InsecureSsl     *string   `yaml:"insecure-ssl,omitempty"`
+	// Secret is the name of the terraform input variable providing the secret, as it is never part of the configuration
+	Secret *string `yaml:"secret,omitempty"`
+}
+
+func (to *GhWebhookConfig) Merge(from *GhWebhookConfig) {
+	if from == nil {
+		return
+	}
+
+	mergeStringIfNotNil(&to.Name, from.Name)
+	mergeSliceIfNotNil(&to.ConfigTemplates, from.ConfigTemplates)
+	mergeStringIfNotNil(&to.Url, from.Url)
+	mergeStringIfNotNil(&to.ContentType, from.ContentType)
+	mergeSliceIfNotNil(&to.Events, from.Events)
+	mergeStringIfNotNil(&to.Active, from.Active)
+	mergeStringIfNotNil(&to.InsecureSsl, from.InsecureSsl)
+	mergeStringIfNotNil(&to.Secret, from.Secret)
+}
@@ -0,0 +1,55 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+func updateGhWebhookConfigHelper(c *core.GhWebhookConfig, stringToCopy *string, newSliceToCopy *[]string, updatePtr bool) {
+	updateStringPtrHelper(&c.Name, stringToCopy, updatePtr)
+	updateSlicePtrHelper(&c.ConfigTemplates, newSliceToCopy, updatePtr)
+	updateStringPtrHelper(&c.Url, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.ContentType, stringToCopy, updatePtr)
+	updateSlicePtrHelper(&c.Events, newSliceToCopy, updatePtr)
+	updateStringPtrHelper(&c.Active, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.InsecureSsl, stringToCopy, updatePtr)
+	updateStringPtrHelper(&c.Secret, stringToCopy, updatePtr)
+}
+
+func TestGhWebhookConfig_Merge(t *testing.T) {
+	t.Parallel()
+	// Ensure updating from afterward doesn't affect result of merge and vice versa
+	ensureMergeWithoutOverflowBetweenToAndFrom(
+		t,
+		core.GhWebhookConfig{},
+		func(to, from *core.GhWebhookConfig) {
+			to.Merge(from)
+		},
+		updateGhWebhookConfigHelper,
+	)
+}
+
+func TestGhWebhooksConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	from := GetFullConfig(1).Webhooks
+	to := GetFullConfig(2).Webhooks
+	expected := GetFullConfig(2).Webhooks
+	*expected = append(*expected, *GetFullConfig(1).Webhooks...)
+
+	to.Merge(from)
+
+	if diff := cmp.Diff(expected, to); diff != "" {
+		t.Errorf("Config mismatch (-want +got):\n%s", diff)
+	}
+
+	// Ensure items have been copied
+	*(*from)[0].Url = "updated"
+
+	if diff := cmp.Diff(expected, to); diff != "" {
+		t.Errorf("Config mismatch after 'from' update (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,137 @@
+package core
+
+import (
+	"strconv"
+
+	"github.com/goccy/go-yaml"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+/** Public **/
+
+// EncodeWithSchema encodes the value to YAML, using types defined by the schema instead of string ones.
+//
+// Config structs store every scalar value as string, encoding them as is would produce quoted booleans and integers
+// (e.g. `archived: "true"`), which are then rejected by schema validation.
+func EncodeWithSchema(value interface{}, schemaURL string) ([]byte, error) {
+	content, err := yaml.Marshal(value)
+	if err != nil {
+		//nolint:wrapcheck // Expected to return unwrap error
+		return nil, err
+	}
+
+	var raw interface{}
+	if err = yaml.UnmarshalWithOptions(content, &raw, yaml.UseOrderedMap()); err != nil {
+		//nolint:wrapcheck // Expected to return unwrap error
+		return nil, err
+	}
+
+	//nolint:wrapcheck // Expected to return unwrap error
+	return yaml.Marshal(applySchemaTypes(raw, []*jsonschema.Schema{Schemas.FindCompiled(schemaURL)}))
+}
+
+/** Private **/
+
+func applySchemaTypes(value interface{}, schemaList []*jsonschema.Schema) interface{} {
+	schemaList = expandSchemas(schemaList, nil)
+
+	switch typed := value.(type) {
+	case yaml.MapSlice:
+		for idx, item := range typed {
+			key, _ := item.Key.(string)
+			typed[idx].Value = applySchemaTypes(item.Value, propertySchemas(schemaList, key))
+		}
+
+		return typed
+	case []interface{}:
+		itemSchemaList := itemSchemas(schemaList)
+		for idx, item := range typed {
+			typed[idx] = applySchemaTypes(item, itemSchemaList)
+		}
+
+		return typed
+	case string:
+		return convertScalar(typed, schemaList)
+	}
+
+	return value
+}
+
+func convertScalar(value string, schemaList []*jsonschema.Schema) interface{} {
+	for _, schema := range schemaList {
+		for _, schemaType := range schema.Types {
+			switch schemaType {
+			case "boolean":
+				if boolValue, err := strconv.ParseBool(value); err == nil {
+					return boolValue
+				}
+			case "integer":
+				if intValue, err := strconv.Atoi(value); err == nil {
+					return intValue
+				}
+			}
+		}
+	}
+
+	return value
+}
+
+// expandSchemas returns provided schemas plus every schema applying to the same instance (references, compositions).
+func expandSchemas(schemaList []*jsonschema.Schema, visited map[*jsonschema.Schema]bool) []*jsonschema.Schema {
+	if visited == nil {
+		visited = map[*jsonschema.Schema]bool{}
+	}
+
+	list := []*jsonschema.Schema{}
+
+	for _, schema := range schemaList {
+		if schema == nil || visited[schema] {
+			continue
+		}
+
+		visited[schema] = true
+		list = append(list, schema)
+
+		subList := append([]*jsonschema.Schema{schema.Ref, schema.Then, schema.Else}, schema.AllOf...)
+		subList = append(subList, schema.AnyOf...)
+		subList = append(subList, schema.OneOf...)
+
+		list = append(list, expandSchemas(subList, visited)...)
+	}
+
+	return list
+}
+
+func propertySchemas(schemaList []*jsonschema.Schema, key string) []*jsonschema.Schema {
+	list := []*jsonschema.Schema{}
+
+	for _, schema := range schemaList {
+		if sub, ok := schema.Properties[key]; ok {
+			list = append(list, sub)
+		}
+
+		for pattern, sub := range schema.PatternProperties {
+			if pattern.MatchString(key) {
+				list = append(list, sub)
+			}
+		}
+	}
+
+	return list
+}
+
+func itemSchemas(schemaList []*jsonschema.Schema) []*jsonschema.Schema {
+	list := []*jsonschema.Schema{}
+
+	for _, schema := range schemaList {
+		if schema.Items2020 != nil {
+			list = append(list, schema.Items2020)
+		}
+
+		if sub, ok := schema.Items.(*jsonschema.Schema); ok {
+			list = append(list, sub)
+		}
+	}
+
+	return list
+}
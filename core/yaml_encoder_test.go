@@ -0,0 +1,52 @@
+package core_test
+
+import (
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+//nolint:paralleltest // Can't be done on parallel as core.YamlAnchorDirectory is used (else race condition)
+func TestEncodeWithSchema(t *testing.T) {
+	core.YamlAnchorDirectory = nil
+
+	cases := map[string]struct {
+		value    *core.GhRepoConfig
+		expected []string
+	}{
+		"Full": {
+			GetFullConfig(1),
+			[]string{"archived: false\n", "approval-count: 4\n", "visibility: visibility1\n"},
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				content, err := core.EncodeWithSchema(tc.value, "map:///repo.json")
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				for _, expected := range tc.expected {
+					if !strings.Contains(string(content), expected) {
+						t.Errorf("%q not found in:\n%s", expected, content)
+					}
+				}
+
+				// Ensure encoded content is valid and decoded to the same config
+				filePath := path.Join(t.TempDir(), "repo.yml")
+				if err = os.WriteFile(filePath, content, 0o600); err != nil {
+					t.Fatal(err)
+				}
+
+				actual, err := core.LoadRepositoryFromFile(filePath)
+				EnsureConfigMatching(t, tc.value, actual, nil, err)
+			},
+		)
+	}
+}
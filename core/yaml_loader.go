@@ -7,6 +7,18 @@ import (
 	"github.com/goccy/go-yaml"
 )
 
+//nolint:gochecknoglobals // Easier to manage it as global var
+var openSshPublicKeyTypes = []string{
+	"ssh-rsa",
+	"ssh-dss",
+	"ssh-ed25519",
+	"ecdsa-sha2-nistp256",
+	"ecdsa-sha2-nistp384",
+	"ecdsa-sha2-nistp521",
+	"sk-ssh-ed25519@openssh.com",
+	"sk-ecdsa-sha2-nistp256@openssh.com",
+}
+
 func LoadRepositoriesFromFile(filePath string, decoderOpts ...yaml.DecodeOption) ([]*GhRepoConfig, error) {
 	if err := ValidateRepositoryConfigs(filePath); err != nil {
 		return nil, err
@@ -50,6 +62,84 @@ func LoadBranchProtectionTemplateFromFile(
 	return LoadGhRepoBranchProtectionConfigFromFile(filePath, decoderOpts...)
 }
 
+func LoadRulesetTemplateFromFile(filePath string, decoderOpts ...yaml.DecodeOption) (*GhRulesetConfig, error) {
+	if err := ValidateRulesetTemplateConfig(filePath); err != nil {
+		return nil, err
+	}
+
+	return LoadGhRulesetConfigFromFile(filePath, decoderOpts...)
+}
+
+func LoadTeamsFromFile(filePath string, decoderOpts ...yaml.DecodeOption) ([]*GhTeamConfig, error) {
+	if err := ValidateTeamConfigs(filePath); err != nil {
+		return nil, err
+	}
+
+	return LoadGhTeamConfigListFromFile(filePath, decoderOpts...)
+}
+
+func LoadTeamTemplateFromFile(filePath string, decoderOpts ...yaml.DecodeOption) (*GhTeamConfig, error) {
+	if err := ValidateTeamTemplateConfig(filePath); err != nil {
+		return nil, err
+	}
+
+	return LoadGhTeamConfigFromFile(filePath, decoderOpts...)
+}
+
+func LoadOrgFromFile(filePath string, decoderOpts ...yaml.DecodeOption) (*GhOrgConfig, error) {
+	if err := ValidateOrgConfig(filePath); err != nil {
+		return nil, err
+	}
+
+	return LoadGhOrgConfigFromFile(filePath, decoderOpts...)
+}
+
+func LoadEnvironmentTemplateFromFile(
+	filePath string,
+	decoderOpts ...yaml.DecodeOption,
+) (*GhEnvironmentConfig, error) {
+	if err := ValidateEnvironmentTemplateConfig(filePath); err != nil {
+		return nil, err
+	}
+
+	return LoadGhEnvironmentConfigFromFile(filePath, decoderOpts...)
+}
+
+func LoadWebhookTemplateFromFile(filePath string, decoderOpts ...yaml.DecodeOption) (*GhWebhookConfig, error) {
+	if err := ValidateWebhookTemplateConfig(filePath); err != nil {
+		return nil, err
+	}
+
+	return LoadGhWebhookConfigFromFile(filePath, decoderOpts...)
+}
+
+func LoadLabelsTemplateFromFile(filePath string, decoderOpts ...yaml.DecodeOption) (*GhLabelsConfig, error) {
+	if err := ValidateLabelsTemplateConfig(filePath); err != nil {
+		return nil, err
+	}
+
+	return LoadGhLabelsConfigFromFile(filePath, decoderOpts...)
+}
+
+func LoadOrgRulesetsFromFile(filePath string, decoderOpts ...yaml.DecodeOption) ([]*GhOrgRulesetConfig, error) {
+	if err := ValidateOrgRulesetConfigs(filePath); err != nil {
+		return nil, err
+	}
+
+	return LoadGhOrgRulesetConfigListFromFile(filePath, decoderOpts...)
+}
+
+func LoadCustomPropertiesFromFile(
+	filePath string,
+	decoderOpts ...yaml.DecodeOption,
+) ([]*GhCustomPropertyConfig, error) {
+	if err := ValidateCustomPropertyConfigs(filePath); err != nil {
+		return nil, err
+	}
+
+	return LoadGhCustomPropertyConfigListFromFile(filePath, decoderOpts...)
+}
+
 // LoadGhRepoConfigFromFile loads the file content to GhRepoConfig struct
 // No schema validation will be performed, use loadRepositoryFromFile or loadRepositoryTemplateFromFile instead !
 func LoadGhRepoConfigFromFile(filePath string, decoderOpts ...yaml.DecodeOption) (*GhRepoConfig, error) {
@@ -139,6 +229,198 @@ func LoadGhRepoBranchProtectionConfigFromFile(
 	return config, nil
 }
 
+// LoadGhRulesetConfigFromFile loads the file content to GhRulesetConfig struct
+// No schema validation will be performed, use loadRulesetTemplateFromFile instead !
+func LoadGhRulesetConfigFromFile(filePath string, decoderOpts ...yaml.DecodeOption) (*GhRulesetConfig, error) {
+	var (
+		content []byte
+		err     error
+	)
+
+	if content, err = os.ReadFile(filePath); err != nil {
+		//nolint:wrapcheck // Expected to return unwrap error
+		return nil, err
+	}
+
+	//nolint:exhaustruct // No need here, simple init
+	config := &GhRulesetConfig{}
+	if err = newDecoder(content, decoderOpts...).Decode(config); err != nil {
+		return nil, FileError(filePath, err)
+	}
+
+	return config, nil
+}
+
+// LoadGhTeamConfigFromFile loads the file content to GhTeamConfig struct
+// No schema validation will be performed, use loadTeamTemplateFromFile instead !
+func LoadGhTeamConfigFromFile(filePath string, decoderOpts ...yaml.DecodeOption) (*GhTeamConfig, error) {
+	var (
+		content []byte
+		err     error
+	)
+
+	if content, err = os.ReadFile(filePath); err != nil {
+		//nolint:wrapcheck // Expected to return unwrap error
+		return nil, err
+	}
+
+	//nolint:exhaustruct // No need here, simple init
+	config := &GhTeamConfig{}
+	if err = newDecoder(content, decoderOpts...).Decode(config); err != nil {
+		return nil, FileError(filePath, err)
+	}
+
+	return config, nil
+}
+
+// LoadGhTeamConfigListFromFile loads the file content to GhTeamConfig struct
+// No schema validation will be performed, use loadTeamsFromFile instead !
+func LoadGhTeamConfigListFromFile(filePath string, decoderOpts ...yaml.DecodeOption) ([]*GhTeamConfig, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		//nolint:wrapcheck // Expected to return unwrap error
+		return nil, err
+	}
+
+	var configs []*GhTeamConfig
+	if err = newDecoder(content, decoderOpts...).Decode(&configs); err != nil {
+		return nil, FileError(filePath, err)
+	}
+
+	return configs, nil
+}
+
+// LoadGhOrgConfigFromFile loads the file content to GhOrgConfig struct
+// No schema validation will be performed, use loadOrgFromFile instead !
+func LoadGhOrgConfigFromFile(filePath string, decoderOpts ...yaml.DecodeOption) (*GhOrgConfig, error) {
+	var (
+		content []byte
+		err     error
+	)
+
+	if content, err = os.ReadFile(filePath); err != nil {
+		//nolint:wrapcheck // Expected to return unwrap error
+		return nil, err
+	}
+
+	//nolint:exhaustruct // No need here, simple init
+	config := &GhOrgConfig{}
+	if err = newDecoder(content, decoderOpts...).Decode(config); err != nil {
+		return nil, FileError(filePath, err)
+	}
+
+	return config, nil
+}
+
+// LoadGhEnvironmentConfigFromFile loads the file content to GhEnvironmentConfig struct
+// No schema validation will be performed, use loadEnvironmentTemplateFromFile instead !
+func LoadGhEnvironmentConfigFromFile(
+	filePath string,
+	decoderOpts ...yaml.DecodeOption,
+) (*GhEnvironmentConfig, error) {
+	var (
+		content []byte
+		err     error
+	)
+
+	if content, err = os.ReadFile(filePath); err != nil {
+		//nolint:wrapcheck // Expected to return unwrap error
+		return nil, err
+	}
+
+	//nolint:exhaustruct // No need here, simple init
+	config := &GhEnvironmentConfig{}
+	if err = newDecoder(content, decoderOpts...).Decode(config); err != nil {
+		return nil, FileError(filePath, err)
+	}
+
+	return config, nil
+}
+
+// LoadGhWebhookConfigFromFile loads the file content to GhWebhookConfig struct
+// No schema validation will be performed, use loadWebhookTemplateFromFile instead !
+func LoadGhWebhookConfigFromFile(filePath string, decoderOpts ...yaml.DecodeOption) (*GhWebhookConfig, error) {
+	var (
+		content []byte
+		err     error
+	)
+
+	if content, err = os.ReadFile(filePath); err != nil {
+		//nolint:wrapcheck // Expected to return unwrap error
+		return nil, err
+	}
+
+	//nolint:exhaustruct // No need here, simple init
+	config := &GhWebhookConfig{}
+	if err = newDecoder(content, decoderOpts...).Decode(config); err != nil {
+		return nil, FileError(filePath, err)
+	}
+
+	return config, nil
+}
+
+// LoadGhLabelsConfigFromFile loads the file content to GhLabelsConfig struct
+// No schema validation will be performed, use loadLabelsTemplateFromFile instead !
+func LoadGhLabelsConfigFromFile(filePath string, decoderOpts ...yaml.DecodeOption) (*GhLabelsConfig, error) {
+	var (
+		content []byte
+		err     error
+	)
+
+	if content, err = os.ReadFile(filePath); err != nil {
+		//nolint:wrapcheck // Expected to return unwrap error
+		return nil, err
+	}
+
+	//nolint:exhaustruct // No need here, simple init
+	config := &GhLabelsConfig{}
+	if err = newDecoder(content, decoderOpts...).Decode(config); err != nil {
+		return nil, FileError(filePath, err)
+	}
+
+	return config, nil
+}
+
+// LoadGhOrgRulesetConfigListFromFile loads the file content to a list of GhOrgRulesetConfig struct
+// No schema validation will be performed, use loadOrgRulesetsFromFile instead !
+func LoadGhOrgRulesetConfigListFromFile(
+	filePath string,
+	decoderOpts ...yaml.DecodeOption,
+) ([]*GhOrgRulesetConfig, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		//nolint:wrapcheck // Expected to return unwrap error
+		return nil, err
+	}
+
+	var configs []*GhOrgRulesetConfig
+	if err = newDecoder(content, decoderOpts...).Decode(&configs); err != nil {
+		return nil, FileError(filePath, err)
+	}
+
+	return configs, nil
+}
+
+// LoadGhCustomPropertyConfigListFromFile loads the file content to a list of GhCustomPropertyConfig struct
+// No schema validation will be performed, use loadCustomPropertiesFromFile instead !
+func LoadGhCustomPropertyConfigListFromFile(
+	filePath string,
+	decoderOpts ...yaml.DecodeOption,
+) ([]*GhCustomPropertyConfig, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		//nolint:wrapcheck // Expected to return unwrap error
+		return nil, err
+	}
+
+	var configs []*GhCustomPropertyConfig
+	if err = newDecoder(content, decoderOpts...).Decode(&configs); err != nil {
+		return nil, FileError(filePath, err)
+	}
+
+	return configs, nil
+}
+
 /** Private **/
 
 func newDecoder(content []byte, decoderOpts ...yaml.DecodeOption) *yaml.Decoder {
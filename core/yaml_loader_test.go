@@ -37,14 +37,14 @@ func TestLoadRepositoryFromFile(t *testing.T) {
 		},
 		"Working": {
 			"testdata/repo.full.yml",
-			GetFullConfig(1),
+			GetFullLoadedConfig(1),
 			nil,
 		},
 		"Working with anchors": {
 			"testdata/repo.with-anchor.yml",
 			&core.GhRepoConfig{
 				&name, nil, nil, &desc, nil, nil, nil,
-				nil, nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 			},
 			nil,
 		},
@@ -88,7 +88,7 @@ func TestLoadRepositoriesFromFile(t *testing.T) {
 		},
 		"Working": {
 			"testdata/repos.full.yml",
-			[]*core.GhRepoConfig{GetFullConfig(1), GetFullConfig(2)},
+			[]*core.GhRepoConfig{GetFullLoadedConfig(1), GetFullLoadedConfig(2)},
 			nil,
 		},
 	}
@@ -107,7 +107,7 @@ func TestLoadRepositoriesFromFile(t *testing.T) {
 func TestLoadRepositoryTemplateFromFile(t *testing.T) {
 	t.Parallel()
 
-	full := GetFullConfig(1)
+	full := GetFullLoadedConfig(1)
 	// Template can't have a Name
 	full.Name = nil
 	cases := map[string]struct {
@@ -151,6 +151,228 @@ func TestLoadRepositoryTemplateFromFile(t *testing.T) {
 	}
 }
 
+//nolint:paralleltest // Can't be done on parallel as core.YamlAnchorDirectory is used (else race condition)
+func TestLoadTeamsFromFile(t *testing.T) {
+	anchorDir := "testdata/yaml-anchors"
+	core.YamlAnchorDirectory = &anchorDir
+
+	cases := map[string]struct {
+		filename string
+		expected []*core.GhTeamConfig
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			nil,
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			nil,
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unexpected property": {
+			"testdata/invalid-config-files/teams/teams.unexpected-property.yml",
+			nil,
+			errors.New("schema validation error: file testdata/invalid-config-files/teams/teams.unexpected-property.yml: /0/unexpected-property not allowed"),
+		},
+		"Working": {
+			"testdata/teams.full.yml",
+			[]*core.GhTeamConfig{GetFullTeamConfig(1), GetFullTeamConfig(2)},
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				conf, err := core.LoadTeamsFromFile(tc.filename)
+				EnsureConfigMatching(t, tc.expected, conf, tc.error, err)
+			},
+		)
+	}
+}
+
+func TestLoadOrgFromFile(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		filename string
+		expected *core.GhOrgConfig
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			nil,
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			nil,
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unexpected property": {
+			"testdata/invalid-config-files/org/org.unexpected-property.yml",
+			nil,
+			errors.New("schema validation error: file testdata/invalid-config-files/org/org.unexpected-property.yml: /unexpected-property not allowed"),
+		},
+		"Working": {
+			"testdata/org.full.yml",
+			GetFullOrgConfig(),
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				conf, err := core.LoadOrgFromFile(tc.filename)
+
+				EnsureConfigMatching(t, tc.expected, conf, tc.error, err)
+			},
+		)
+	}
+}
+
+func TestLoadOrgRulesetsFromFile(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		filename string
+		expected []*core.GhOrgRulesetConfig
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			nil,
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			nil,
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unexpected property": {
+			"testdata/invalid-config-files/org/org-rulesets.unexpected-property.yml",
+			nil,
+			errors.New("schema validation error: file testdata/invalid-config-files/org/org-rulesets.unexpected-property.yml: /0/repositories/unexpected-property not allowed"),
+		},
+		"Working": {
+			"testdata/org-rulesets.full.yml",
+			GetFullOrgRulesetsConfig(),
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				conf, err := core.LoadOrgRulesetsFromFile(tc.filename)
+
+				EnsureConfigMatching(t, tc.expected, conf, tc.error, err)
+			},
+		)
+	}
+}
+
+func TestLoadCustomPropertiesFromFile(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		filename string
+		expected []*core.GhCustomPropertyConfig
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			nil,
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			nil,
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unexpected property": {
+			"testdata/invalid-config-files/org/custom-properties.unexpected-property.yml",
+			nil,
+			errors.New("schema validation error: file testdata/invalid-config-files/org/custom-properties.unexpected-property.yml: /0/unexpected-property not allowed"),
+		},
+		"Working": {
+			"testdata/custom-properties.full.yml",
+			GetFullCustomPropertiesConfig(),
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				conf, err := core.LoadCustomPropertiesFromFile(tc.filename)
+
+				EnsureConfigMatching(t, tc.expected, conf, tc.error, err)
+			},
+		)
+	}
+}
+
+func TestLoadTeamTemplateFromFile(t *testing.T) {
+	t.Parallel()
+
+	full := GetFullTeamConfig(1)
+	// Template can't have a Name
+	full.Name = nil
+	cases := map[string]struct {
+		filename string
+		expected *core.GhTeamConfig
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			nil,
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			nil,
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unexpected property": {
+			"testdata/invalid-config-files/templates/team.unexpected-property.yml",
+			nil,
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/team.unexpected-property.yml: /unexpected-property not allowed"),
+		},
+		"Working": {
+			"testdata/team-template.full.yml",
+			full,
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				conf, err := core.LoadTeamTemplateFromFile(tc.filename)
+
+				EnsureConfigMatching(t, tc.expected, conf, tc.error, err)
+			},
+		)
+	}
+}
+
 func TestLoadBranchTemplateFromFile(t *testing.T) {
 	t.Parallel()
 
@@ -243,10 +465,192 @@ func TestLoadBranchProtectionTemplateFromFile(t *testing.T) {
 	}
 }
 
+func TestLoadRulesetTemplateFromFile(t *testing.T) {
+	t.Parallel()
+
+	full := (*GetFullConfig(1).Rulesets)[0]
+	// Name is not part of a ruleset template
+	full.Name = nil
+	cases := map[string]struct {
+		filename string
+		expected *core.GhRulesetConfig
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			nil,
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			nil,
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unexpected property": {
+			"testdata/invalid-config-files/templates/ruleset.unexpected-property.yml",
+			nil,
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/ruleset.unexpected-property.yml: /unexpected-property not allowed"),
+		},
+		"Working": {
+			"testdata/ruleset-template.full.yml",
+			full,
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				conf, err := core.LoadRulesetTemplateFromFile(tc.filename)
+
+				EnsureConfigMatching(t, tc.expected, conf, tc.error, err)
+			},
+		)
+	}
+}
+
+func TestLoadEnvironmentTemplateFromFile(t *testing.T) {
+	t.Parallel()
+
+	full := (*GetFullConfig(1).Environments)["environment1"]
+	cases := map[string]struct {
+		filename string
+		expected *core.GhEnvironmentConfig
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			nil,
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			nil,
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unexpected property": {
+			"testdata/invalid-config-files/templates/environment.unexpected-property.yml",
+			nil,
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/environment.unexpected-property.yml: /unexpected-property not allowed"),
+		},
+		"Working": {
+			"testdata/environment-template.full.yml",
+			full,
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				conf, err := core.LoadEnvironmentTemplateFromFile(tc.filename)
+
+				EnsureConfigMatching(t, tc.expected, conf, tc.error, err)
+			},
+		)
+	}
+}
+
+func TestLoadWebhookTemplateFromFile(t *testing.T) {
+	t.Parallel()
+
+	full := (*GetFullConfig(1).Webhooks)[0]
+	cases := map[string]struct {
+		filename string
+		expected *core.GhWebhookConfig
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			nil,
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			nil,
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unexpected property": {
+			"testdata/invalid-config-files/templates/webhook.unexpected-property.yml",
+			nil,
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/webhook.unexpected-property.yml: /unexpected-property not allowed"),
+		},
+		"Working": {
+			"testdata/webhook-template.full.yml",
+			full,
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				conf, err := core.LoadWebhookTemplateFromFile(tc.filename)
+
+				EnsureConfigMatching(t, tc.expected, conf, tc.error, err)
+			},
+		)
+	}
+}
+
+func TestLoadLabelsTemplateFromFile(t *testing.T) {
+	t.Parallel()
+
+	full := GetFullConfig(1).Labels
+	cases := map[string]struct {
+		filename string
+		expected *core.GhLabelsConfig
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			nil,
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			nil,
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unexpected property": {
+			"testdata/invalid-config-files/templates/labels.unexpected-property.yml",
+			nil,
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/labels.unexpected-property.yml: /items/0/unexpected-property not allowed"),
+		},
+		"Working": {
+			"testdata/labels-template.full.yml",
+			full,
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				conf, err := core.LoadLabelsTemplateFromFile(tc.filename)
+
+				EnsureConfigMatching(t, tc.expected, conf, tc.error, err)
+			},
+		)
+	}
+}
+
 func TestLoadGhRepoConfigFromFile(t *testing.T) {
 	t.Parallel()
 
-	full := GetFullConfig(1)
+	full := GetFullLoadedConfig(1)
 	repoName := "repo-name"
 	cases := map[string]struct {
 		filename string
@@ -267,7 +671,7 @@ func TestLoadGhRepoConfigFromFile(t *testing.T) {
 			"testdata/invalid-config-files/repos/repo.unexpected-property.yml",
 			&core.GhRepoConfig{
 				&repoName, nil, nil, nil, nil, nil, nil,
-				nil, nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 			},
 			nil,
 		},
@@ -295,7 +699,7 @@ func TestLoadGhRepoConfigFromFile(t *testing.T) {
 func TestLoadGhRepoConfigListFromFile(t *testing.T) {
 	t.Parallel()
 
-	full1, full2 := GetFullConfig(1), GetFullConfig(2)
+	full1, full2 := GetFullLoadedConfig(1), GetFullLoadedConfig(2)
 	repoName := "repo-name"
 	cases := map[string]struct {
 		filename string
@@ -317,7 +721,7 @@ func TestLoadGhRepoConfigListFromFile(t *testing.T) {
 			[]*core.GhRepoConfig{
 				{
 					&repoName, nil, nil, nil, nil, nil, nil,
-					nil, nil, nil, nil,
+					nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 				},
 			},
 			nil,
@@ -0,0 +1,411 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+)
+
+// yamlMappingEdit describes a change on a YAML mapping of a repository config.
+type yamlMappingEdit struct {
+	// path from the repository mapping to the mapping to edit, either mapping keys or sequence indexes
+	path []interface{}
+	// isRemoved returns true if the entry must be removed
+	isRemoved func(key string) bool
+	// templates replaces `_templates` list
+	templates []string
+}
+
+/** Public **/
+
+// RewriteRepoConfigFile applies repository config changes to the YAML content of a repository config file (either
+// a single repository or a list of repositories). It returns the name of rewritten repositories, if any.
+//
+// Only lines of updated entries are replaced, comments, formatting, key order, anchors and aliases of the rest of the
+// file are kept. An error is returned rather than rewriting the file if an updated entry defines an anchor, or is
+// provided by an alias, a merge key or a flow style mapping.
+func (e *ExtractedTemplates) RewriteRepoConfigFile(content []byte) ([]byte, []string, error) {
+	repoNameList, repoList := MapToSortedListWithKeys(e.UpdatedRepos)
+	rewrittenList := []string{}
+
+	for idx, repoName := range repoNameList {
+		for _, edit := range repoConfigEdits(e.originalRepos[repoName], repoList[idx]) {
+			newContent, found, err := applyYamlMappingEdit(content, repoName, edit)
+			if err != nil {
+				return nil, nil, YamlRewriteError(repoName, yamlPathString(edit.path), err)
+			}
+
+			if !found {
+				// Repository is declared in another file
+				break
+			}
+
+			content = newContent
+
+			if !slices.Contains(rewrittenList, repoName) {
+				rewrittenList = append(rewrittenList, repoName)
+			}
+		}
+	}
+
+	return content, rewrittenList, nil
+}
+
+/** Private **/
+
+// repoConfigEdits returns changes made by template extraction. Branch protection edits come first, as they are
+// nested into the repository mapping.
+func repoConfigEdits(original *GhRepoConfig, updated *GhRepoConfig) []yamlMappingEdit {
+	if original == nil || updated == nil {
+		return nil
+	}
+
+	editList := []yamlMappingEdit{}
+	appendProtectionEdit := func(originalProtection, protection *BaseGhBranchProtectionConfig, path ...interface{}) {
+		if protection == nil || protection.ConfigTemplates == nil || reflect.DeepEqual(originalProtection, protection) {
+			return
+		}
+
+		editList = append(editList, yamlMappingEdit{path, isBranchProtectionContent, *protection.ConfigTemplates})
+	}
+
+	if original.DefaultBranch != nil && updated.DefaultBranch != nil {
+		appendProtectionEdit(
+			original.DefaultBranch.Protection,
+			updated.DefaultBranch.Protection,
+			"default-branch",
+			"protection",
+		)
+	}
+
+	if original.Branches != nil && updated.Branches != nil {
+		keys, branchList := MapToSortedListWithKeys(*updated.Branches)
+		for idx, name := range keys {
+			if branch := (*original.Branches)[name]; branch != nil && branchList[idx] != nil {
+				appendProtectionEdit(branch.Protection, branchList[idx].Protection, "branches", name, "protection")
+			}
+		}
+	}
+
+	if original.BranchProtections != nil && updated.BranchProtections != nil {
+		for idx, protection := range *updated.BranchProtections {
+			appendProtectionEdit(
+				&(*original.BranchProtections)[idx].BaseGhBranchProtectionConfig,
+				&protection.BaseGhBranchProtectionConfig,
+				"branch-protections",
+				idx,
+			)
+		}
+	}
+
+	removedSections := []string{}
+
+	for _, section := range repoTemplateSections() {
+		if !reflect.ValueOf(section.getter(original)).Elem().IsNil() &&
+			reflect.ValueOf(section.getter(updated)).Elem().IsNil() {
+			removedSections = append(removedSections, section.name)
+		}
+	}
+
+	if updated.ConfigTemplates != nil &&
+		(len(removedSections) > 0 || !reflect.DeepEqual(original.ConfigTemplates, updated.ConfigTemplates)) {
+		editList = append(editList, yamlMappingEdit{
+			nil,
+			func(key string) bool { return slices.Contains(removedSections, key) },
+			*updated.ConfigTemplates,
+		})
+	}
+
+	return editList
+}
+
+// isBranchProtectionContent returns true for keys replaced by a branch protection template.
+func isBranchProtectionContent(key string) bool {
+	return key != "pattern" && key != "forbid"
+}
+
+// applyYamlMappingEdit returns false if the repository is not declared in the content.
+func applyYamlMappingEdit(content []byte, repoName string, edit yamlMappingEdit) ([]byte, bool, error) {
+	file, err := parser.ParseBytes(content, parser.ParseComments)
+	if err != nil {
+		//nolint:wrapcheck // Expected to return unwrap error
+		return nil, false, err
+	}
+
+	node := findRepoMapping(file, repoName)
+	if node == nil {
+		return nil, false, nil
+	}
+
+	for _, step := range edit.path {
+		if node, err = yamlChildNode(node, step); err != nil {
+			return nil, false, err
+		}
+	}
+
+	entryList, err := yamlMappingEntries(node)
+	if err != nil {
+		return nil, false, err
+	}
+
+	lines := strings.Split(string(content), "\n")
+	start, end, newLines, err := rewriteYamlMappingLines(lines, entryList, edit)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	lines = slices.Replace(lines, start, end+1, newLines...)
+
+	return []byte(strings.Join(lines, "\n")), true, nil
+}
+
+// findRepoMapping returns the node of the repository, or nil if not found.
+func findRepoMapping(file *ast.File, repoName string) ast.Node {
+	for _, doc := range file.Docs {
+		itemList := []ast.Node{doc.Body}
+		if sequence, ok := doc.Body.(*ast.SequenceNode); ok {
+			itemList = sequence.Values
+		}
+
+		for _, item := range itemList {
+			if yamlRepoName(item) == repoName {
+				return item
+			}
+		}
+	}
+
+	return nil
+}
+
+// yamlRepoName returns the name of the repository node, even if it can't be rewritten (e.g. anchored mapping).
+func yamlRepoName(node ast.Node) string {
+	if anchor, ok := node.(*ast.AnchorNode); ok {
+		node = anchor.Value
+	}
+
+	var entryList []*ast.MappingValueNode
+
+	switch typed := node.(type) {
+	case *ast.MappingValueNode:
+		entryList = []*ast.MappingValueNode{typed}
+	case *ast.MappingNode:
+		entryList = typed.Values
+	}
+
+	for _, entry := range entryList {
+		if yamlScalarString(entry.Key) == "name" {
+			return yamlScalarString(entry.Value)
+		}
+	}
+
+	return ""
+}
+
+func yamlChildNode(node ast.Node, step interface{}) (ast.Node, error) {
+	if index, ok := step.(int); ok {
+		sequence, isSequence := node.(*ast.SequenceNode)
+		if !isSequence || index >= len(sequence.Values) {
+			return nil, ErrYamlNodeNotFound
+		}
+
+		return sequence.Values[index], nil
+	}
+
+	entryList, err := yamlMappingEntries(node)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entryList {
+		if yamlScalarString(entry.Key) == step {
+			return entry.Value, nil
+		}
+	}
+
+	return nil, ErrYamlNodeNotFound
+}
+
+// yamlMappingEntries returns entries of a block style mapping without anchor, alias or merge key.
+func yamlMappingEntries(node ast.Node) ([]*ast.MappingValueNode, error) {
+	var entryList []*ast.MappingValueNode
+
+	switch typed := node.(type) {
+	case *ast.MappingValueNode:
+		entryList = []*ast.MappingValueNode{typed}
+	case *ast.MappingNode:
+		if typed.IsFlowStyle {
+			return nil, ErrYamlFlowStyleMapping
+		}
+
+		entryList = typed.Values
+	case *ast.AnchorNode, *ast.AliasNode:
+		return nil, ErrYamlAnchorOrAlias
+	default:
+		return nil, ErrYamlNodeNotFound
+	}
+
+	for _, entry := range entryList {
+		if _, isMergeKey := entry.Key.(*ast.MergeKeyNode); isMergeKey {
+			return nil, ErrYamlMergeKey
+		}
+	}
+
+	return entryList, nil
+}
+
+// rewriteYamlMappingLines returns the first and last lines (0 based) of the mapping, and lines replacing them.
+//
+// An entry starts with comment lines above its key, and ends with the last line of its value.
+func rewriteYamlMappingLines(
+	lines []string,
+	entryList []*ast.MappingValueNode,
+	edit yamlMappingEdit,
+) (int, int, []string, error) {
+	keyPosition := entryList[0].Key.GetToken().Position
+	keyIndent := keyPosition.Column - 1
+	start := keyPosition.Line - 1
+	// First line may start with a sequence item indicator
+	prefix := lines[start][:keyIndent]
+	indent := strings.Repeat(" ", keyIndent)
+	templatesLine := indent + "_templates: " + yamlFlowList(edit.templates)
+	// Existing templates are replaced in place, new ones are added after the name
+	templatesAdded := slices.ContainsFunc(entryList, func(entry *ast.MappingValueNode) bool {
+		return yamlScalarString(entry.Key) == "_templates"
+	})
+	newLines := []string{}
+
+	lines = slices.Clone(lines)
+	lines[start] = indent + lines[start][keyIndent:]
+
+	entryStart, entryEnd := start, start
+
+	for idx, entry := range entryList {
+		keyLine := entry.Key.GetToken().Position.Line - 1
+		if idx+1 < len(entryList) {
+			entryEnd = trimYamlTrailingLines(lines, keyLine, entryList[idx+1].Key.GetToken().Position.Line-2, keyIndent)
+		} else {
+			entryEnd = findYamlLastEntryEnd(lines, entry, keyIndent)
+		}
+
+		switch key := yamlScalarString(entry.Key); {
+		case key == "_templates":
+			// Comments above are kept
+			newLines = append(append(newLines, lines[entryStart:keyLine]...), templatesLine)
+		case edit.isRemoved(key):
+			if len(ast.Filter(ast.AnchorType, entry.Value)) > 0 {
+				return 0, 0, nil, YamlEntryError(key, ErrYamlAnchorOrAlias)
+			}
+		default:
+			newLines = append(newLines, lines[entryStart:entryEnd+1]...)
+
+			if !templatesAdded && (key == "name" || key == "pattern") {
+				newLines = append(newLines, templatesLine)
+				templatesAdded = true
+			}
+		}
+
+		entryStart = entryEnd + 1
+	}
+
+	if !templatesAdded {
+		newLines = slices.Insert(newLines, 0, templatesLine)
+	}
+
+	return start, entryEnd, prefixYamlMappingLines(newLines, prefix), nil
+}
+
+// prefixYamlMappingLines restores the sequence item indicator of a mapping, if any, as first entry may have been
+// removed.
+func prefixYamlMappingLines(lines []string, prefix string) []string {
+	indent := strings.Repeat(" ", len(prefix))
+	if prefix == indent {
+		return lines
+	}
+
+	for idx, line := range lines {
+		switch {
+		case strings.TrimSpace(line) == "":
+			continue
+		case strings.HasPrefix(line, indent):
+			lines[idx] = prefix + line[len(prefix):]
+
+			return lines
+		default:
+			// Less indented comment
+			return slices.Insert(lines, idx, strings.TrimRight(prefix, " "))
+		}
+	}
+
+	return lines
+}
+
+// findYamlLastEntryEnd returns the last line of the last entry of a mapping, which is either followed by a line less
+// indented than the key, or by an entry of the same indentation (sequences may be as indented as their key).
+func findYamlLastEntryEnd(lines []string, entry *ast.MappingValueNode, keyIndent int) int {
+	keyLine := entry.Key.GetToken().Position.Line - 1
+	_, isSequence := entry.Value.(*ast.SequenceNode)
+	end := keyLine
+
+	for idx := keyLine + 1; idx < len(lines); idx++ {
+		trimmed := strings.TrimLeft(lines[idx], " ")
+		indent := len(lines[idx]) - len(trimmed)
+
+		if trimmed != "" && !strings.HasPrefix(trimmed, "#") &&
+			(indent < keyIndent || (indent == keyIndent && !(isSequence && strings.HasPrefix(trimmed, "-")))) {
+			break
+		}
+
+		end = idx
+	}
+
+	return trimYamlTrailingLines(lines, keyLine, end, keyIndent)
+}
+
+// trimYamlTrailingLines excludes trailing empty lines and comment lines not indented more than keys, as they are
+// related to the next entry.
+func trimYamlTrailingLines(lines []string, start int, end int, keyIndent int) int {
+	for end > start {
+		trimmed := strings.TrimLeft(lines[end], " ")
+		if trimmed != "" && (!strings.HasPrefix(trimmed, "#") || len(lines[end])-len(trimmed) > keyIndent) {
+			break
+		}
+
+		end--
+	}
+
+	return end
+}
+
+func yamlScalarString(node ast.Node) string {
+	if scalar, ok := node.(ast.ScalarNode); ok {
+		if value, isString := scalar.GetValue().(string); isString {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func yamlFlowList(list []string) string {
+	content, err := yaml.MarshalWithOptions(list, yaml.Flow(true))
+	if err != nil {
+		return "[]"
+	}
+
+	return strings.TrimSpace(string(content))
+}
+
+func yamlPathString(path []interface{}) string {
+	itemList := []string{}
+	for _, step := range path {
+		itemList = append(itemList, fmt.Sprint(step))
+	}
+
+	return strings.Join(itemList, ".")
+}
@@ -27,6 +27,19 @@ var (
 		"map:///branch.json":                            {Content: &branchSchema},
 		"map:///default-branch.json":                    {Content: &defaultBranchSchema},
 		"map:///repo-template.json":                     {Content: &repositoryTemplateSchema},
+		"map:///team.json":                              {Content: &teamConfigSchema},
+		"map:///teams.json":                             {Content: &teamsConfigSchema},
+		"map:///team-template.json":                     {Content: &teamTemplateSchema},
+		"map:///org.json":                               {Content: &orgConfigSchema},
+		"map:///ruleset.json":                           {Content: &rulesetSchema},
+		"map:///ruleset-template.json":                  {Content: &rulesetTemplateSchema},
+		"map:///org-ruleset.json":                       {Content: &orgRulesetSchema},
+		"map:///org-rulesets.json":                      {Content: &orgRulesetsSchema},
+		"map:///environment-template.json":              {Content: &environmentTemplateSchema},
+		"map:///webhook-template.json":                  {Content: &webhookTemplateSchema},
+		"map:///labels-template.json":                   {Content: &labelsTemplateSchema},
+		"map:///actions.json":                           {Content: &actionsSchema},
+		"map:///custom-properties.json":                 {Content: &customPropertiesSchema},
 	}
 
 	//go:embed schemas/repo.json
@@ -58,6 +71,45 @@ var (
 
 	//go:embed schemas/repo-template.json
 	repositoryTemplateSchema string
+
+	//go:embed schemas/team.json
+	teamConfigSchema string
+
+	//go:embed schemas/teams.json
+	teamsConfigSchema string
+
+	//go:embed schemas/team-template.json
+	teamTemplateSchema string
+
+	//go:embed schemas/org.json
+	orgConfigSchema string
+
+	//go:embed schemas/ruleset.json
+	rulesetSchema string
+
+	//go:embed schemas/ruleset-template.json
+	rulesetTemplateSchema string
+
+	//go:embed schemas/org-ruleset.json
+	orgRulesetSchema string
+
+	//go:embed schemas/org-rulesets.json
+	orgRulesetsSchema string
+
+	//go:embed schemas/environment-template.json
+	environmentTemplateSchema string
+
+	//go:embed schemas/webhook-template.json
+	webhookTemplateSchema string
+
+	//go:embed schemas/labels-template.json
+	labelsTemplateSchema string
+
+	//go:embed schemas/actions.json
+	actionsSchema string
+
+	//go:embed schemas/custom-properties.json
+	customPropertiesSchema string
 )
 
 //nolint:gochecknoinits // Kind of require in order to load custom schemas
@@ -119,6 +171,87 @@ func ValidateBranchProtectionTemplateConfig(filePath string) error {
 	return _normalizeValidationError(filePath, Schemas.FindCompiled("map:///branch-protection-template.json").Validate(i))
 }
 
+func ValidateRulesetTemplateConfig(filePath string) error {
+	var i interface{}
+	if err := loadAsInterface(filePath, &i); err != nil {
+		return err
+	}
+
+	return _normalizeValidationError(filePath, Schemas.FindCompiled("map:///ruleset-template.json").Validate(i))
+}
+
+func ValidateTeamConfigs(filePath string) error {
+	var i interface{}
+	if err := loadAsInterface(filePath, &i); err != nil {
+		return err
+	}
+
+	return _normalizeValidationError(filePath, Schemas.FindCompiled("map:///teams.json").Validate(i))
+}
+
+func ValidateTeamTemplateConfig(filePath string) error {
+	var i interface{}
+	if err := loadAsInterface(filePath, &i); err != nil {
+		return err
+	}
+
+	return _normalizeValidationError(filePath, Schemas.FindCompiled("map:///team-template.json").Validate(i))
+}
+
+func ValidateOrgConfig(filePath string) error {
+	var i interface{}
+	if err := loadAsInterface(filePath, &i); err != nil {
+		return err
+	}
+
+	return _normalizeValidationError(filePath, Schemas.FindCompiled("map:///org.json").Validate(i))
+}
+
+func ValidateEnvironmentTemplateConfig(filePath string) error {
+	var i interface{}
+	if err := loadAsInterface(filePath, &i); err != nil {
+		return err
+	}
+
+	return _normalizeValidationError(filePath, Schemas.FindCompiled("map:///environment-template.json").Validate(i))
+}
+
+func ValidateWebhookTemplateConfig(filePath string) error {
+	var i interface{}
+	if err := loadAsInterface(filePath, &i); err != nil {
+		return err
+	}
+
+	return _normalizeValidationError(filePath, Schemas.FindCompiled("map:///webhook-template.json").Validate(i))
+}
+
+func ValidateLabelsTemplateConfig(filePath string) error {
+	var i interface{}
+	if err := loadAsInterface(filePath, &i); err != nil {
+		return err
+	}
+
+	return _normalizeValidationError(filePath, Schemas.FindCompiled("map:///labels-template.json").Validate(i))
+}
+
+func ValidateOrgRulesetConfigs(filePath string) error {
+	var i interface{}
+	if err := loadAsInterface(filePath, &i); err != nil {
+		return err
+	}
+
+	return _normalizeValidationError(filePath, Schemas.FindCompiled("map:///org-rulesets.json").Validate(i))
+}
+
+func ValidateCustomPropertyConfigs(filePath string) error {
+	var i interface{}
+	if err := loadAsInterface(filePath, &i); err != nil {
+		return err
+	}
+
+	return _normalizeValidationError(filePath, Schemas.FindCompiled("map:///custom-properties.json").Validate(i))
+}
+
 /** Private **/
 
 func loadAsInterface(filePath string, receiver *interface{}) error {
@@ -101,6 +101,34 @@ func TestValidateRepositoryTemplateConfig(t *testing.T) {
 			"testdata/invalid-config-files/templates/repo.unexpected-property.yml",
 			errors.New("schema validation error: file testdata/invalid-config-files/templates/repo.unexpected-property.yml: /unexpected-property not allowed"),
 		},
+		"Actions patterns without selected actions": {
+			"testdata/invalid-config-files/templates/repo.actions-patterns-without-selected.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/repo.actions-patterns-without-selected.yml: /actions/permissions not failed"),
+		},
+		"Push protection without secret scanning": {
+			"testdata/invalid-config-files/templates/repo.push-protection-without-secret-scanning.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/repo.push-protection-without-secret-scanning.yml: /security not failed"),
+		},
+		"Unsupported code scanning language": {
+			"testdata/invalid-config-files/templates/repo.code-scanning-unsupported-language.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/repo.code-scanning-unsupported-language.yml: /security/code-scanning/languages/1 value must be one of \"actions\", \"c-cpp\", \"csharp\", \"go\", \"java-kotlin\", \"javascript-typescript\", \"python\", \"ruby\", \"swift\""),
+		},
+		"Autolink without number placeholder": {
+			"testdata/invalid-config-files/templates/repo.autolink-without-num.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/repo.autolink-without-num.yml: /autolinks/TICKET-/url-template does not match pattern '<num>'"),
+		},
+		"Invalid bypasser": {
+			"testdata/invalid-config-files/templates/repo.invalid-bypasser.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/repo.invalid-bypasser.yml: /branch-protections/0/pull-request-reviews/bypassers/0 does not match pattern '^(@.+|/?[A-Za-z0-9-]+|[A-Za-z0-9-]+/[A-Za-z0-9._-]+)$'"),
+		},
+		"Deploy key without key": {
+			"testdata/invalid-config-files/templates/repo.deploy-key-without-key.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/repo.deploy-key-without-key.yml: /deploy-keys/0 missing properties: 'key-file'"),
+		},
+		"File source outside of files directory": {
+			"testdata/invalid-config-files/templates/repo.file-source-outside-files-directory.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/repo.file-source-outside-files-directory.yml: /files/SECURITY.md/source not failed"),
+		},
 		"Working": {
 			"testdata/repo-template.full.yml",
 			nil,
@@ -118,6 +146,82 @@ func TestValidateRepositoryTemplateConfig(t *testing.T) {
 	}
 }
 
+func TestValidateTeamConfigs(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		filename string
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unwanted property": {
+			"testdata/invalid-config-files/teams/teams.unexpected-property.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/teams/teams.unexpected-property.yml: /0/unexpected-property not allowed"),
+		},
+		"Secret team with a parent": {
+			"testdata/invalid-config-files/teams/teams.secret-with-parent.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/teams/teams.secret-with-parent.yml: /0 not failed"),
+		},
+		"Working": {
+			"testdata/teams.full.yml",
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+				EnsureErrorMatching(t, tc.error, core.ValidateTeamConfigs(tc.filename))
+			},
+		)
+	}
+}
+
+func TestValidateTeamTemplateConfig(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		filename string
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unwanted property": {
+			"testdata/invalid-config-files/templates/team.unexpected-property.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/team.unexpected-property.yml: /unexpected-property not allowed"),
+		},
+		"Working": {
+			"testdata/team-template.full.yml",
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+				EnsureErrorMatching(t, tc.error, core.ValidateTeamTemplateConfig(tc.filename))
+			},
+		)
+	}
+}
+
 //nolint:paralleltest // Can't be done on parallel as core.YamlAnchorDirectory is used (else race condition)
 func TestValidateBranchProtectionTemplateConfig(t *testing.T) {
 	// Reset YamlAnchorDirectory, so it's certain to cover getYamlValidatorDecoderOptions default return
@@ -154,3 +258,282 @@ func TestValidateBranchProtectionTemplateConfig(t *testing.T) {
 		)
 	}
 }
+
+func TestValidateRulesetTemplateConfig(t *testing.T) {
+	// Reset YamlAnchorDirectory, so it's certain to cover getYamlValidatorDecoderOptions default return
+	core.YamlAnchorDirectory = nil
+
+	cases := map[string]struct {
+		filename string
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unwanted property": {
+			"testdata/invalid-config-files/templates/ruleset.unexpected-property.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/ruleset.unexpected-property.yml: /unexpected-property not allowed"),
+		},
+		"Working": {
+			"testdata/ruleset-template.full.yml",
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				EnsureErrorMatching(t, tc.error, core.ValidateRulesetTemplateConfig(tc.filename))
+			},
+		)
+	}
+}
+
+func TestValidateEnvironmentTemplateConfig(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		filename string
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unwanted property": {
+			"testdata/invalid-config-files/templates/environment.unexpected-property.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/environment.unexpected-property.yml: /unexpected-property not allowed"),
+		},
+		"Patterns with protected branches only": {
+			"testdata/invalid-config-files/templates/environment.protected-only-with-patterns.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/environment.protected-only-with-patterns.yml: /deployment-branches not failed"),
+		},
+		"Working": {
+			"testdata/environment-template.full.yml",
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				EnsureErrorMatching(t, tc.error, core.ValidateEnvironmentTemplateConfig(tc.filename))
+			},
+		)
+	}
+}
+
+func TestValidateWebhookTemplateConfig(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		filename string
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unwanted property": {
+			"testdata/invalid-config-files/templates/webhook.unexpected-property.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/webhook.unexpected-property.yml: /unexpected-property not allowed"),
+		},
+		"Invalid URL": {
+			"testdata/invalid-config-files/templates/webhook.invalid-url.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/webhook.invalid-url.yml: /url does not match pattern '^https?://'"),
+		},
+		"Working": {
+			"testdata/webhook-template.full.yml",
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				EnsureErrorMatching(t, tc.error, core.ValidateWebhookTemplateConfig(tc.filename))
+			},
+		)
+	}
+}
+
+func TestValidateLabelsTemplateConfig(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		filename string
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unwanted property": {
+			"testdata/invalid-config-files/templates/labels.unexpected-property.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/labels.unexpected-property.yml: /items/0/unexpected-property not allowed"),
+		},
+		"Invalid color": {
+			"testdata/invalid-config-files/templates/labels.invalid-color.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/templates/labels.invalid-color.yml: /items/0/color does not match pattern '^[0-9a-fA-F]{6}$'"),
+		},
+		"Working": {
+			"testdata/labels-template.full.yml",
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+
+				EnsureErrorMatching(t, tc.error, core.ValidateLabelsTemplateConfig(tc.filename))
+			},
+		)
+	}
+}
+
+func TestValidateOrgConfig(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		filename string
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unwanted property": {
+			"testdata/invalid-config-files/org/org.unexpected-property.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/org/org.unexpected-property.yml: /unexpected-property not allowed"),
+		},
+		"Billing email is mandatory": {
+			"testdata/invalid-config-files/org/org.no-billing-email.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/org/org.no-billing-email.yml:  missing properties: 'billing-email'"),
+		},
+		"Working": {
+			"testdata/org.full.yml",
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+				EnsureErrorMatching(t, tc.error, core.ValidateOrgConfig(tc.filename))
+			},
+		)
+	}
+}
+
+func TestValidateOrgRulesetConfigs(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		filename string
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unwanted property": {
+			"testdata/invalid-config-files/org/org-rulesets.unexpected-property.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/org/org-rulesets.unexpected-property.yml: /0/repositories/unexpected-property not allowed"),
+		},
+		"Missing name": {
+			"testdata/invalid-config-files/org/org-rulesets.no-name.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/org/org-rulesets.no-name.yml: /0 missing properties: 'name'"),
+		},
+		"Working": {
+			"testdata/org-rulesets.full.yml",
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+				EnsureErrorMatching(t, tc.error, core.ValidateOrgRulesetConfigs(tc.filename))
+			},
+		)
+	}
+}
+
+func TestValidateCustomPropertyConfigs(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		filename string
+		error    error
+	}{
+		"Not found file": {
+			"an_unknown_file",
+			errors.New("open an_unknown_file: no such file or directory"),
+		},
+		"Empty": {
+			"testdata/invalid-config-files/empty.yml",
+			errors.New("file testdata/invalid-config-files/empty.yml: EOF"),
+		},
+		"Unwanted property": {
+			"testdata/invalid-config-files/org/custom-properties.unexpected-property.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/org/custom-properties.unexpected-property.yml: /0/unexpected-property not allowed"),
+		},
+		"Select without allowed values": {
+			"testdata/invalid-config-files/org/custom-properties.no-allowed-values.yml",
+			errors.New("schema validation error: file testdata/invalid-config-files/org/custom-properties.no-allowed-values.yml: /0 missing properties: 'allowed-values'"),
+		},
+		"Working": {
+			"testdata/custom-properties.full.yml",
+			nil,
+		},
+	}
+
+	for tcname, tc := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				t.Parallel()
+				EnsureErrorMatching(t, tc.error, core.ValidateCustomPropertyConfigs(tc.filename))
+			},
+		)
+	}
+}
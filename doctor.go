@@ -0,0 +1,46 @@
+package main
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+const doctorCommand = "doctor"
+
+// loadYamlAndDiagnoseConfig warns about repository config fields which don't affect generated terraform files.
+func loadYamlAndDiagnoseConfig(workspacePath, configDir, templateDir, yamlAnchorDir, filesDir string) int {
+	config, exitCode := loadYamlAndComputeConfig(workspacePath, configDir, templateDir, yamlAnchorDir, filesDir)
+	if exitCode != noErrorExitCode {
+		return exitCode
+	}
+
+	ctx := core.NewWorkspaceContext(config)
+	ignoredCount := 0
+
+	for _, repoConfig := range config.Repos {
+		for _, path := range findIgnoredRepoFieldsSilently(repoConfig, ctx) {
+			log.Warn().Msgf("Repository %s: %s is ignored, it doesn't affect generated terraform files", *repoConfig.Name, path)
+
+			ignoredCount++
+		}
+	}
+
+	if ignoredCount == 0 {
+		log.Info().Msgf("No ignored field")
+	}
+
+	return noErrorExitCode
+}
+
+// findIgnoredRepoFieldsSilently disables logs while terraform files are generated for each updated field, generation
+// warnings are reported by the write command.
+func findIgnoredRepoFieldsSilently(repoConfig *core.GhRepoConfig, ctx *core.WorkspaceContext) []string {
+	logLevel := zerolog.GlobalLevel()
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+
+	defer zerolog.SetGlobalLevel(logLevel)
+
+	return core.FindIgnoredRepoFields(repoConfig, ctx)
+}
@@ -13,9 +13,16 @@ var (
 	errDuringWorkspaceLoading = errors.New("error during workspace loading")
 	errDuringConfigsLoading   = errors.New("error during configs loading")
 	errDuringTemplateLoading  = errors.New("error during templates loading")
+	errDuringConfigWriting    = errors.New("error while writing config files")
+	errGhCliIsRequired        = errors.New("GitHub CLI is required by some settings, use --gh-cli option")
 
 	errInputDirectoryDoesntExist = errors.New("input directory doesn't exist")
 	errRepositoryAlreadyImported = errors.New("repository already imported")
+	errOrgConfigAlreadyLoaded    = errors.New("organization config already loaded")
+	errConfigFileAlreadyExists   = errors.New("config file already exists")
+	errRepositoryFileNotFound    = errors.New("repository config file not found")
+	errManagedWithGhCli          = errors.New("is managed with GitHub CLI")
+	errStateFileIsMandatory      = errors.New("state file is mandatory, use --state option")
 )
 
 func workspaceLoadingError(errList []error) error {
@@ -36,6 +43,30 @@ func templateLoadingError(errList []error) error {
 	return fmt.Errorf("%w:%s%w", errDuringTemplateLoading, separator, core.JoinErrors(errList, separator))
 }
 
+func configFileWritingError(errList []error) error {
+	const separator = "\n\t - "
+
+	return fmt.Errorf("%w:%s%w", errDuringConfigWriting, separator, core.JoinErrors(errList, separator))
+}
+
+func ghCliRequiredError(errList []error) error {
+	const separator = "\n\t - "
+
+	return fmt.Errorf("%w:%s%w", errGhCliIsRequired, separator, core.JoinErrors(errList, separator))
+}
+
+func ghCliSettingError(repoName string, setting string) error {
+	return fmt.Errorf("repository %s: %s %w", repoName, setting, errManagedWithGhCli)
+}
+
+func configFileAlreadyExistsError(path string) error {
+	return fmt.Errorf("%w: %s", errConfigFileAlreadyExists, path)
+}
+
+func repositoryFileNotFoundError(repoName string) error {
+	return fmt.Errorf("%w: %s", errRepositoryFileNotFound, repoName)
+}
+
 func inputDirectoryDoesntExistError(path string) error {
 	return fmt.Errorf("%w: %s", errInputDirectoryDoesntExist, path)
 }
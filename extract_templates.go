@@ -0,0 +1,197 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+const extractTemplatesCommand = "extract-templates"
+
+func loadYamlAndExtractTemplates(
+	workspacePath, configDir, templateDir, yamlAnchorDir, filesDir string,
+	minRepoCount int,
+) int {
+	rawConfig, err := readWorkspace(workspacePath, configDir, templateDir, yamlAnchorDir)
+	if err != nil {
+		log.Error().Msgf("%s", err)
+
+		return readWorkspaceErrorExitCode
+	}
+
+	// Repository file sources are checked during config computation
+	configureFilesDirectory(workspacePath, filesDir)
+
+	extracted, err := core.ExtractTemplates(rawConfig, minRepoCount)
+	if err != nil {
+		log.Error().Msgf("%s", err)
+
+		return extractTemplatesErrorExitCode
+	}
+
+	if len(extracted.Repos) == 0 && len(extracted.BranchProtections) == 0 {
+		log.Info().Msgf("No template to extract")
+
+		return noErrorExitCode
+	}
+
+	// Ensure nothing is written if a repository config file can't be rewritten
+	repoFileList, err := rewriteRepositoryConfigFiles(filepath.Join(workspacePath, configDir), extracted)
+	if err != nil {
+		log.Error().Msgf("%s", err)
+
+		return writeConfigFilesErrorExitCode
+	}
+
+	if err = writeTemplateFiles(filepath.Join(workspacePath, templateDir), extracted); err != nil {
+		log.Error().Msgf("%s", err)
+
+		return writeConfigFilesErrorExitCode
+	}
+
+	if err = writeRewrittenRepositoryConfigFiles(repoFileList); err != nil {
+		log.Error().Msgf("%s", err)
+
+		return writeConfigFilesErrorExitCode
+	}
+
+	return noErrorExitCode
+}
+
+func writeTemplateFiles(rootPath string, extracted *core.ExtractedTemplates) error {
+	type templateFile struct {
+		value     interface{}
+		schemaURL string
+	}
+
+	fileList := map[string]*templateFile{}
+	for tplName, tpl := range extracted.Repos {
+		fileList[filepath.Join(rootPath, tplName+".repo.yml")] = &templateFile{tpl, "map:///repo-template.json"}
+	}
+
+	for tplName, tpl := range extracted.BranchProtections {
+		fileList[filepath.Join(rootPath, tplName+".branch-protection.yml")] = &templateFile{
+			tpl,
+			"map:///branch-protection-template.json",
+		}
+	}
+
+	filePathList, templateFileList := core.MapToSortedListWithKeys(fileList)
+
+	// Ensure nothing is written if a template file already exists
+	errList := []error{}
+
+	for _, filePath := range filePathList {
+		if _, err := os.Stat(filePath); err == nil {
+			errList = append(errList, configFileAlreadyExistsError(filePath))
+		} else if !errors.Is(err, os.ErrNotExist) {
+			errList = append(errList, err)
+		}
+	}
+
+	if len(errList) > 0 {
+		return configFileWritingError(errList)
+	}
+
+	//nolint:gomnd // Doesn't make sense here to wrap directory permissions
+	if err := os.MkdirAll(rootPath, 0o755); err != nil {
+		return configFileWritingError([]error{err})
+	}
+
+	for idx, filePath := range filePathList {
+		if err := writeYamlFile(filePath, templateFileList[idx].value, templateFileList[idx].schemaURL); err != nil {
+			errList = append(errList, err)
+		} else {
+			log.Info().Msgf("Template written: %s", filePath)
+		}
+	}
+
+	if len(errList) > 0 {
+		return configFileWritingError(errList)
+	}
+
+	return nil
+}
+
+// rewriteRepositoryConfigFiles returns the new content of files declaring updated repository configs, by file path.
+func rewriteRepositoryConfigFiles(rootPath string, extracted *core.ExtractedTemplates) (map[string][]byte, error) {
+	pathList := []string{}
+
+	for _, filename := range []string{"repos.yml", "repos.yaml"} {
+		if _, err := os.Stat(filepath.Join(rootPath, filename)); err == nil {
+			pathList = append(pathList, filepath.Join(rootPath, filename))
+		}
+	}
+
+	fileList, _ := filepath.Glob(filepath.Join(rootPath, "repos", "*.y*ml"))
+	for _, filePath := range fileList {
+		if ext := filepath.Ext(filePath); ext == ".yml" || ext == ".yaml" {
+			pathList = append(pathList, filePath)
+		}
+	}
+
+	contentList := map[string][]byte{}
+	rewrittenList := map[string]bool{}
+	errList := []error{}
+
+	for _, filePath := range pathList {
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			errList = append(errList, err)
+
+			continue
+		}
+
+		newContent, repoNameList, err := extracted.RewriteRepoConfigFile(content)
+		if err != nil {
+			errList = append(errList, core.FileError(filePath, err))
+
+			continue
+		}
+
+		if len(repoNameList) > 0 {
+			contentList[filePath] = newContent
+		}
+
+		for _, repoName := range repoNameList {
+			rewrittenList[repoName] = true
+		}
+	}
+
+	repoNameList, _ := core.MapToSortedListWithKeys(extracted.UpdatedRepos)
+	for _, repoName := range repoNameList {
+		if !rewrittenList[repoName] && len(errList) == 0 {
+			errList = append(errList, repositoryFileNotFoundError(repoName))
+		}
+	}
+
+	if len(errList) > 0 {
+		return nil, configFileWritingError(errList)
+	}
+
+	return contentList, nil
+}
+
+func writeRewrittenRepositoryConfigFiles(contentList map[string][]byte) error {
+	errList := []error{}
+	filePathList, fileContentList := core.MapToSortedListWithKeys(contentList)
+
+	for idx, filePath := range filePathList {
+		//nolint:gomnd,gosec // Same permissions as terraform files
+		if err := os.WriteFile(filePath, fileContentList[idx], 0o644); err != nil {
+			errList = append(errList, err)
+		} else {
+			log.Info().Msgf("Repository config updated: %s", filePath)
+		}
+	}
+
+	if len(errList) > 0 {
+		return configFileWritingError(errList)
+	}
+
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	flag "github.com/spf13/pflag"
+
+	"github.com/yoanm/go-github-tf/core"
 )
 
 // Build time variables.
@@ -28,10 +30,29 @@ var (
 	defaultTemplateDirFlag   = "templates"
 	yamlAnchorDirFlag        string
 	defaultYamlAnchorDirFlag = "yaml-anchors"
+	filesDirFlag             string
+	defaultFilesDirFlag      = "files"
+
+	// Import flags.
+	printImportsFlag          bool
+	importBlocksFlag          bool
+	skipImportListFlag        []string
+	defaultSkipImportListFlag []string
+
+	// Pruning flags.
+	listOrphansFlag bool
+
+	// Check flags.
+	checkFlag bool
+
+	// GitHub CLI flags.
+	ghCliFlag bool
 
-	// printImportsFlag          bool
-	// skipImportListFlag        []string
-	// defaultSkipImportListFlag []string = nil.
+	// Reverse flags.
+	statePathFlag string
+
+	// Template extraction flags.
+	minRepoCountFlag int
 
 	// Logging flags.
 	verboseFlag     int
@@ -50,19 +71,62 @@ func init() {
 	flag.StringVarP(&configDirFlag, "config", "c", defaultConfigDirFlag, `Config directory`)
 	flag.StringVarP(&templateDirFlag, "templates", "t", defaultTemplateDirFlag, `Template directory`)
 	flag.StringVar(&yamlAnchorDirFlag, "yaml-anchors", defaultYamlAnchorDirFlag, `YAML anchors directory`)
+	flag.StringVar(&filesDirFlag, "files", defaultFilesDirFlag, `Repository files directory`)
+
+	flag.BoolVar(
+		&printImportsFlag,
+		"print-imports",
+		false,
+		"Print terraform import commands related to the configuration instead of writing terraform files",
+	)
+	flag.BoolVar(
+		&importBlocksFlag,
+		"import-blocks",
+		false,
+		"Also write terraform import blocks (Terraform 1.5+) for repositories and branches into "+core.ImportsFilename+
+			", 'terraform plan' fails on the ones not existing yet, use --skip for them",
+	)
+	flag.StringSliceVar(
+		&skipImportListFlag,
+		"skip",
+		defaultSkipImportListFlag,
+		"Skip provided resource addresses (e.g. github_repository.my-repo) from import commands or import blocks",
+	)
+
+	flag.BoolVar(
+		&listOrphansFlag,
+		"list-orphans",
+		false,
+		"List terraform files generated by a previous run and not generated anymore, instead of removing them",
+	)
+
+	flag.BoolVar(
+		&checkFlag,
+		"check",
+		false,
+		"Compare generated terraform files with existing ones instead of writing them, print a diff and fail on drift",
+	)
 
-	// flag.BoolVar(
-	// 	&printImportsFlag,
-	// 	"print-imports",
-	// 	false,
-	// 	"Read terraform files and print related terraform import commands"
-	// )
-	// flag.StringSliceVar(
-	// 	&skipImportListFlag,
-	// 	"skip",
-	// 	defaultSkipImportListFlag,
-	// 	"Skip provided import from the list"
-	// )
+	flag.BoolVar(
+		&ghCliFlag,
+		"gh-cli",
+		false,
+		"Manage settings not supported by the GitHub terraform provider with GitHub CLI calls (see README)",
+	)
+
+	flag.StringVar(
+		&statePathFlag,
+		"state",
+		"",
+		"Terraform state file (or 'terraform show -json' output) used by '"+reverseCommand+"' command",
+	)
+
+	flag.IntVar(
+		&minRepoCountFlag,
+		"min-repos",
+		core.DefaultTemplateExtractionMinRepos,
+		"Minimum number of repositories sharing a config used by '"+extractTemplatesCommand+"' command",
+	)
 
 	flag.BoolVarP(&quietFlag, "quiet", "q", false, "Disable output")
 	flag.CountVarP(&verboseFlag, "verbose", "v", "Enable verbose output. -v for Info, -vv for Debug and -vvv for Trace")
@@ -80,12 +144,13 @@ func run() int {
 	parseFlags()
 	setupLogOutput(computeLogLevel(), disableAnsiFlag)
 
-	terraformDir := "terraform"
+	terraformDir := defaultTerraformDir
 
 	log.Debug().Msgf("Workspace: %s", workspacePathFlag)
 	log.Debug().Msgf("Config directory: %s", configDirFlag)
 	log.Debug().Msgf("Template directory: %s", templateDirFlag)
 	log.Debug().Msgf("YAML anchor directory: %s", yamlAnchorDirFlag)
+	log.Debug().Msgf("Files directory: %s", filesDirFlag)
 
 	exitCode := 0
 
@@ -95,10 +160,46 @@ func run() int {
 	case versionFlag:
 		//nolint:forbidigo // Expected output
 		fmt.Printf("github-tf version: %s (commit %s from %s)\n", version, commit, date)
-
-		/*}  else if printImportsFlag {
-		exitCode = printTerraformImports(filepath.Join(workspacePathFlag, terraformDir), &skipImportListFlag)
-		*/
+	case flag.Arg(0) == reverseCommand:
+		exitCode = loadStateAndWriteYaml(workspacePathFlag, configDirFlag, statePathFlag)
+	case flag.Arg(0) == doctorCommand:
+		exitCode = loadYamlAndDiagnoseConfig(
+			workspacePathFlag,
+			configDirFlag,
+			templateDirFlag,
+			yamlAnchorDirFlag,
+			filesDirFlag,
+		)
+	case flag.Arg(0) == extractTemplatesCommand:
+		exitCode = loadYamlAndExtractTemplates(
+			workspacePathFlag,
+			configDirFlag,
+			templateDirFlag,
+			yamlAnchorDirFlag,
+			filesDirFlag,
+			minRepoCountFlag,
+		)
+	case printImportsFlag:
+		exitCode = loadYamlAndPrintTerraformImports(
+			workspacePathFlag,
+			configDirFlag,
+			templateDirFlag,
+			yamlAnchorDirFlag,
+			filesDirFlag,
+			skipImportListFlag,
+		)
+	case checkFlag:
+		exitCode = loadYamlAndCheckTerraform(
+			workspacePathFlag,
+			configDirFlag,
+			templateDirFlag,
+			terraformDir,
+			yamlAnchorDirFlag,
+			filesDirFlag,
+			importBlocksFlag,
+			skipImportListFlag,
+			ghCliFlag,
+		)
 	default:
 		exitCode = loadYamlAndWriteTerraform(
 			workspacePathFlag,
@@ -106,6 +207,11 @@ func run() int {
 			templateDirFlag,
 			terraformDir,
 			yamlAnchorDirFlag,
+			filesDirFlag,
+			importBlocksFlag,
+			skipImportListFlag,
+			ghCliFlag,
+			listOrphansFlag,
 		)
 	}
 
@@ -133,8 +239,15 @@ func parseFlags() {
 	configDirFlag = defaultConfigDirFlag
 	templateDirFlag = defaultTemplateDirFlag
 	yamlAnchorDirFlag = defaultYamlAnchorDirFlag
-	// printImportsFlag = false
-	// skipImportListFlag = defaultSkipImportListFlag
+	filesDirFlag = defaultFilesDirFlag
+	printImportsFlag = false
+	importBlocksFlag = false
+	skipImportListFlag = defaultSkipImportListFlag
+	listOrphansFlag = false
+	checkFlag = false
+	ghCliFlag = false
+	statePathFlag = ""
+	minRepoCountFlag = core.DefaultTemplateExtractionMinRepos
 	helpFlag = false
 	verboseFlag = 0
 	quietFlag = false
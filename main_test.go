@@ -50,6 +50,10 @@ func TestCLIWrite_withComputationErrors(t *testing.T) {
 	cases := []string{
 		"unknown-template",
 		"default-branch-template-without-default-branch",
+		"duplicated-labels",
+		"missing-file-source",
+		"invalid-custom-properties",
+		"invalid-deploy-keys",
 	}
 	for _, tcname := range cases {
 		t.Run(
@@ -86,6 +90,23 @@ func TestCLIWrite_working(t *testing.T) {
 		"with-templates-and-anchors",
 		"multiple-branch-protection-for-same-pattern",
 		"default-branch-branch-protection-template-with-existing-config",
+		"with-import-blocks",
+		"prune-orphans",
+		"renamed-repository",
+		"with-teams",
+		"with-org",
+		"with-rulesets",
+		"with-org-rulesets",
+		"with-environments",
+		"with-actions",
+		"with-actions-permissions",
+		"with-webhooks",
+		"with-labels",
+		"with-files",
+		"with-security",
+		"with-deploy-keys",
+		"with-autolinks-and-custom-properties",
+		"with-branch-protection-bypassers",
 	}
 	for _, tcname := range cases {
 		t.Run(
@@ -97,6 +118,108 @@ func TestCLIWrite_working(t *testing.T) {
 	}
 }
 
+func TestCLIImports_working(t *testing.T) {
+	cases := []string{
+		"base",
+	}
+	for _, tcname := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				configure(t, filepath.Join("testdata/imports/working", tcname)).Run(t, false)
+			},
+		)
+	}
+}
+
+func TestCLICheck(t *testing.T) {
+	cases := []string{
+		"up-to-date",
+		"drift",
+	}
+	for _, tcname := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				configure(t, filepath.Join("testdata/check", tcname)).Run(t, false)
+			},
+		)
+	}
+}
+
+func TestCLIReverse_working(t *testing.T) {
+	cases := []string{
+		"base",
+	}
+	for _, tcname := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				configure(t, filepath.Join("testdata/reverse/working", tcname)).Run(t, false)
+			},
+		)
+	}
+}
+
+func TestCLIReverse_withErrors(t *testing.T) {
+	cases := []string{
+		"missing-state-option",
+		"unknown-repository",
+	}
+	for _, tcname := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				configure(t, filepath.Join("testdata/reverse/errors", tcname)).Run(t, false)
+			},
+		)
+	}
+}
+
+func TestCLIExtractTemplates_working(t *testing.T) {
+	cases := []string{
+		"base",
+		"with-files",
+	}
+	for _, tcname := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				configure(t, filepath.Join("testdata/extract-templates/working", tcname)).Run(t, false)
+			},
+		)
+	}
+}
+
+func TestCLIExtractTemplates_withErrors(t *testing.T) {
+	cases := []string{
+		"anchored-section",
+	}
+	for _, tcname := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				configure(t, filepath.Join("testdata/extract-templates/errors", tcname)).Run(t, false)
+			},
+		)
+	}
+}
+
+func TestCLIDoctor_working(t *testing.T) {
+	cases := []string{
+		"base",
+		"no-ignored-field",
+	}
+	for _, tcname := range cases {
+		t.Run(
+			tcname,
+			func(t *testing.T) {
+				configure(t, filepath.Join("testdata/doctor/working", tcname)).Run(t, false)
+			},
+		)
+	}
+}
+
 func configure(t *testing.T, testdataPath string) *cmdtest.TestSuite {
 	t.Helper()
 
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+const reverseCommand = "reverse"
+
+func loadStateAndWriteYaml(workspacePath, configDir, statePath string) int {
+	if statePath == "" {
+		log.Error().Msgf("%s", errStateFileIsMandatory)
+
+		return readStateErrorExitCode
+	}
+
+	resources, err := core.LoadTerraformStateFromFile(statePath)
+	if err != nil {
+		log.Error().Msgf("%s", err)
+
+		return readStateErrorExitCode
+	}
+
+	repoConfigs, err := core.MapStateToRepoConfigs(resources)
+	if err != nil {
+		log.Error().Msgf("%s", err)
+
+		return reverseMappingErrorExitCode
+	}
+
+	log.Info().Msgf("Found: %d repos", len(repoConfigs))
+
+	if err = writeRepositoryConfigFiles(path.Join(workspacePath, configDir, "repos"), repoConfigs); err != nil {
+		log.Error().Msgf("%s", err)
+
+		return writeConfigFilesErrorExitCode
+	}
+
+	return noErrorExitCode
+}
+
+func writeRepositoryConfigFiles(rootPath string, repoConfigs []*core.GhRepoConfig) error {
+	if len(repoConfigs) == 0 {
+		return nil
+	}
+
+	//nolint:gomnd // Doesn't make sense here to wrap directory permissions
+	if err := os.MkdirAll(rootPath, 0o755); err != nil {
+		return configFileWritingError([]error{err})
+	}
+
+	errList := []error{}
+
+	for _, repoConfig := range repoConfigs {
+		filePath := path.Join(rootPath, *repoConfig.Name+".yml")
+
+		if _, err := os.Stat(filePath); err == nil {
+			errList = append(errList, configFileAlreadyExistsError(filePath))
+
+			continue
+		} else if !errors.Is(err, os.ErrNotExist) {
+			errList = append(errList, err)
+
+			continue
+		}
+
+		if err := writeYamlFile(filePath, repoConfig, "map:///repo.json"); err != nil {
+			errList = append(errList, err)
+
+			continue
+		}
+
+		log.Info().Msgf("Repository config written: %s", filePath)
+	}
+
+	if len(errList) > 0 {
+		return configFileWritingError(errList)
+	}
+
+	return nil
+}
+
+// writeYamlFile writes the value encoded with types defined by the schema.
+func writeYamlFile(filePath string, value interface{}, schemaURL string) error {
+	content, err := core.EncodeWithSchema(value, schemaURL)
+	if err != nil {
+		return core.FileError(filePath, err)
+	}
+
+	//nolint:gomnd,gosec // Same permissions as terraform files
+	if err = os.WriteFile(filePath, content, 0o644); err != nil {
+		//nolint:wrapcheck // Expected to return raw error
+		return err
+	}
+
+	return nil
+}
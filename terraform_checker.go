@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+func loadYamlAndCheckTerraform(
+	workspacePath, configDir, templateDir, terraformDir, yamlAnchorDir, filesDir string,
+	withImportBlocks bool,
+	skipImportList []string,
+	withGhCli bool,
+) int {
+	config, exitCode := loadYamlAndComputeConfig(workspacePath, configDir, templateDir, yamlAnchorDir, filesDir)
+	if exitCode != noErrorExitCode {
+		return exitCode
+	}
+
+	files, exitCode := generateTerraformFiles(config, withImportBlocks, skipImportList, withGhCli)
+	if exitCode != noErrorExitCode {
+		return exitCode
+	}
+
+	diffList, err := core.DiffTerraformFiles(path.Join(workspacePath, terraformDir), files)
+	if err != nil {
+		log.Error().Msgf("%s", err)
+
+		return checkTerraformFilesErrorExitCode
+	}
+
+	if len(diffList) == 0 {
+		log.Info().Msgf("Terraform files are up to date")
+
+		return noErrorExitCode
+	}
+
+	for _, diff := range core.MapToSortedList(diffList) {
+		//nolint:forbidigo // Expected output
+		fmt.Print(diff)
+	}
+
+	log.Error().Msgf("%d terraform file(s) not up to date", len(diffList))
+
+	return terraformFilesDriftExitCode
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/yoanm/go-github-tf/core"
+)
+
+// importBlockResourceTypes contains resource types written as import blocks, see filterImportBlockResources.
+//
+//nolint:gochecknoglobals // Easier than duplicate it everywhere needed
+var importBlockResourceTypes = []string{
+	"github_repository",
+	"github_branch_default",
+	"github_branch",
+	"github_branch_protection",
+}
+
+func loadYamlAndPrintTerraformImports(
+	workspacePath, configDir, templateDir, yamlAnchorDir, filesDir string,
+	skipList []string,
+) int {
+	config, exitCode := loadYamlAndComputeConfig(workspacePath, configDir, templateDir, yamlAnchorDir, filesDir)
+	if exitCode != noErrorExitCode {
+		return exitCode
+	}
+
+	resources, err := generateImportableResources(config, skipList)
+	if err != nil {
+		log.Error().Msgf("%s", err)
+
+		return generateTerraformFilesErrorExitCode
+	}
+
+	for _, res := range resources {
+		switch {
+		case res.ImportId == "":
+			log.Warn().Msgf("Unable to compute import ID for %s => ignored", res.Address)
+		default:
+			//nolint:forbidigo // Expected output
+			fmt.Printf("terraform import %s %s\n", res.Address, shellQuote(res.ImportId))
+		}
+	}
+
+	return noErrorExitCode
+}
+
+func generateImportableResources(config *core.Config, skipList []string) ([]*core.TerraformResource, error) {
+	resources, err := core.GenerateResources(config)
+	if err != nil {
+		return nil, err
+	}
+
+	skipped := make(map[string]bool, len(skipList))
+	for _, address := range skipList {
+		skipped[address] = true
+	}
+
+	list := make([]*core.TerraformResource, 0, len(resources))
+
+	for _, res := range resources {
+		if skipped[res.Address] {
+			log.Debug().Msgf("Import skipped for %s", res.Address)
+		} else {
+			list = append(list, res)
+		}
+	}
+
+	return list, nil
+}
+
+// filterImportBlockResources keeps repository, default branch, branch and branch protection resources only. Other
+// resources (labels, webhooks, etc.) usually don't exist yet, and "terraform plan" fails on import blocks targeting
+// missing objects.
+func filterImportBlockResources(resources []*core.TerraformResource) []*core.TerraformResource {
+	list := make([]*core.TerraformResource, 0, len(resources))
+
+	for _, res := range resources {
+		resType, _, _ := strings.Cut(res.Address, ".")
+		if slices.Contains(importBlockResourceTypes, resType) {
+			list = append(list, res)
+		} else {
+			log.Debug().Msgf("Import block skipped for %s", res.Address)
+		}
+	}
+
+	return list
+}
+
+// shellQuote wraps value with single quotes, so it can be safely copy/pasted in a shell
+// (patterns like "release/*" would be expanded otherwise).
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -3,20 +3,137 @@ package main
 import (
 	"path"
 
+	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/rs/zerolog/log"
 
 	"github.com/yoanm/go-github-tf/core"
 )
 
+// Terraform files are written in this directory, relative to the workspace.
+const defaultTerraformDir = "terraform"
+
 const (
 	noErrorExitCode                     = 0
 	readWorkspaceErrorExitCode          = 1
 	computeConfigErrorExitCode          = 2
 	generateTerraformFilesErrorExitCode = 3
 	writeTerraformFilesErrorExitCode    = 4
+	pruneTerraformFilesErrorExitCode    = 5
+	checkTerraformFilesErrorExitCode    = 6
+	terraformFilesDriftExitCode         = 7
+	readStateErrorExitCode              = 8
+	reverseMappingErrorExitCode         = 9
+	writeConfigFilesErrorExitCode       = 10
+	extractTemplatesErrorExitCode       = 11
 )
 
-func loadYamlAndWriteTerraform(workspacePath, configDir, templateDir, terraformDir, yamlAnchorDir string) int {
+func loadYamlAndWriteTerraform(
+	workspacePath, configDir, templateDir, terraformDir, yamlAnchorDir, filesDir string,
+	withImportBlocks bool,
+	skipImportList []string,
+	withGhCli bool,
+	listOrphans bool,
+) int {
+	config, exitCode := loadYamlAndComputeConfig(workspacePath, configDir, templateDir, yamlAnchorDir, filesDir)
+	if exitCode != noErrorExitCode {
+		return exitCode
+	}
+
+	files, exitCode := generateTerraformFiles(config, withImportBlocks, skipImportList, withGhCli)
+	if exitCode != noErrorExitCode {
+		return exitCode
+	}
+
+	terraformPath := path.Join(workspacePath, terraformDir)
+
+	if err := core.WriteTerraformFiles(terraformPath, files); err != nil {
+		log.Error().Msgf("%s", err)
+
+		return writeTerraformFilesErrorExitCode
+	}
+
+	orphanList, err := core.PruneTerraformFiles(terraformPath, files, listOrphans)
+	for _, fName := range orphanList {
+		if listOrphans {
+			log.Warn().Msgf("Orphaned terraform file: %s", path.Join(terraformPath, fName))
+		} else {
+			log.Info().Msgf("Orphaned terraform file removed: %s", path.Join(terraformPath, fName))
+		}
+	}
+
+	if err != nil {
+		log.Error().Msgf("%s", err)
+
+		return pruneTerraformFilesErrorExitCode
+	}
+
+	return noErrorExitCode
+}
+
+func generateTerraformFiles(
+	config *core.Config,
+	withImportBlocks bool,
+	skipImportList []string,
+	withGhCli bool,
+) (map[string]*hclwrite.File, int) {
+	if !withGhCli {
+		if err := checkGhCliSettings(config); err != nil {
+			log.Error().Msgf("%s", err)
+
+			return nil, generateTerraformFilesErrorExitCode
+		}
+	}
+
+	files, err := core.GenerateHclFiles(config)
+	if err != nil {
+		log.Error().Msgf("%s", err)
+
+		return nil, generateTerraformFilesErrorExitCode
+	}
+
+	if withImportBlocks {
+		resources, resErr := generateImportableResources(config, skipImportList)
+		if resErr != nil {
+			log.Error().Msgf("%s", resErr)
+
+			return nil, generateTerraformFilesErrorExitCode
+		}
+
+		files[core.ImportsFilename] = core.GenerateHclImportFile(filterImportBlockResources(resources))
+	}
+
+	return files, noErrorExitCode
+}
+
+// checkGhCliSettings ensures no repository uses a setting managed with GitHub CLI calls, as GitHub CLI must be
+// available wherever terraform is applied.
+func checkGhCliSettings(config *core.Config) error {
+	errList := []error{}
+
+	for _, repoConfig := range config.Repos {
+		if repoConfig.Security == nil {
+			continue
+		}
+
+		if repoConfig.Security.PrivateVulnerabilityReporting != nil {
+			errList = append(errList, ghCliSettingError(*repoConfig.Name, "security.private-vulnerability-reporting"))
+		}
+
+		if repoConfig.Security.CodeScanning != nil {
+			errList = append(errList, ghCliSettingError(*repoConfig.Name, "security.code-scanning"))
+		}
+	}
+
+	if len(errList) > 0 {
+		return ghCliRequiredError(errList)
+	}
+
+	return nil
+}
+
+func loadYamlAndComputeConfig(
+	workspacePath, configDir, templateDir, yamlAnchorDir, filesDir string,
+) (*core.Config, int) {
 	var err error
 
 	var rawConfig *core.Config
@@ -24,7 +141,7 @@ func loadYamlAndWriteTerraform(workspacePath, configDir, templateDir, terraformD
 	if rawConfig, err = readWorkspace(workspacePath, configDir, templateDir, yamlAnchorDir); err != nil {
 		log.Error().Msgf("%s", err)
 
-		return readWorkspaceErrorExitCode
+		return nil, readWorkspaceErrorExitCode
 	}
 
 	core.ConfigTrace("Decoded config", rawConfig)
@@ -37,28 +154,17 @@ func loadYamlAndWriteTerraform(workspacePath, configDir, templateDir, terraformD
 		len(rawConfig.Templates.BranchProtections),
 	)
 
+	configureFilesDirectory(workspacePath, filesDir)
+
 	var config *core.Config
 
 	if config, err = core.ComputeConfig(rawConfig); err != nil {
 		log.Error().Msgf("%s", err)
 
-		return computeConfigErrorExitCode
+		return nil, computeConfigErrorExitCode
 	}
 
 	core.ConfigTrace("Computed config", config)
 
-	files, err := core.GenerateHclRepoFiles(config.Repos)
-	if err != nil {
-		log.Error().Msgf("%s", err)
-
-		return generateTerraformFilesErrorExitCode
-	}
-
-	if err = core.WriteTerraformFiles(path.Join(workspacePath, terraformDir), files); err != nil {
-		log.Error().Msgf("%s", err)
-
-		return writeTerraformFilesErrorExitCode
-	}
-
-	return noErrorExitCode
+	return config, noErrorExitCode
 }
@@ -0,0 +1,15 @@
+resource "github_repository" "repo1" {
+  name = "repo1"
+
+  description = "old description"
+
+  topics = ["a", "b"]
+
+  has_issues = true
+  has_wiki   = false
+}
+
+resource "github_branch_default" "repo1" {
+  repository = github_repository.repo1.name
+  branch     = "master"
+}
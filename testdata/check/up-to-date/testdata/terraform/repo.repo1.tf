@@ -0,0 +1,15 @@
+resource "github_repository" "repo1" {
+  name = "repo1"
+
+  description = "a description"
+
+  topics = ["a", "b"]
+
+  has_issues = true
+  has_wiki   = true
+}
+
+resource "github_branch_default" "repo1" {
+  repository = github_repository.repo1.name
+  branch     = "master"
+}
@@ -0,0 +1,3 @@
+resource "github_repository" "repo2" {
+  name = "repo2"
+}
@@ -0,0 +1,3 @@
+resource "github_repository" "old" {
+  name = "old"
+}
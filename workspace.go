@@ -22,6 +22,8 @@ func readWorkspace(rootPath, configDir, templateDir, yamlAnchorDir string) (*cor
 	}
 
 	configureYamlAnchorDirectory(rootPath, yamlAnchorDir)
+	// Deploy key files are relative to the workspace
+	core.WorkspaceDirectory = &rootPath
 
 	decoderOpts := []yaml.DecodeOption{yaml.UseOrderedMap()}
 
@@ -54,6 +56,18 @@ func configureYamlAnchorDirectory(path string, yamlAnchorDir string) {
 	core.YamlAnchorDirectory = &anchorDir
 }
 
+// configureFilesDirectory configures where repository file sources are, and how generated terraform files reach them.
+func configureFilesDirectory(path string, filesDir string) {
+	sourceDir := filepath.Join(path, filesDir)
+
+	if relPath, err := filepath.Rel(filepath.Join(path, defaultTerraformDir), sourceDir); err == nil {
+		core.FilesModuleDirectory = filepath.ToSlash(relPath)
+	}
+
+	// Sources are reported as not found if the directory doesn't exist
+	core.FilesDirectory = &sourceDir
+}
+
 func readConfigDirectory(config *core.Config, rootPath string, decoderOpts []yaml.DecodeOption) error {
 	if _, err := os.Stat(rootPath); os.IsNotExist(err) {
 		// Nothing to do
@@ -119,6 +133,14 @@ func loadConfigDirectoryFile(
 		loadReposConfigFile(config, filename, path, decoderOpts, errList, visited)
 	case filename == "repos":
 		loadReposConfigDirectory(config, path, decoderOpts, errList, visited)
+	case filename == "teams.yaml" || filename == "teams.yml":
+		loadTeamsConfigFile(config, filename, path, decoderOpts, errList)
+	case filename == "org.yaml" || filename == "org.yml":
+		loadOrgConfigFile(config, filename, path, decoderOpts, errList)
+	case filename == "org-rulesets.yaml" || filename == "org-rulesets.yml":
+		loadOrgRulesetsConfigFile(config, filename, path, decoderOpts, errList)
+	case filename == "custom-properties.yaml" || filename == "custom-properties.yml":
+		loadCustomPropertiesConfigFile(config, filename, path, decoderOpts, errList)
 	default:
 		log.Debug().Msgf("%s is not a known file or directory => ignored", path)
 	}
@@ -162,6 +184,86 @@ func loadReposConfigFile(
 	}
 }
 
+func loadTeamsConfigFile(
+	config *core.Config,
+	filename string,
+	path string,
+	decoderOpts []yaml.DecodeOption,
+	errList map[string]error,
+) {
+	teamConfigs, loadErr := core.LoadTeamsFromFile(path, decoderOpts...)
+	if loadErr != nil {
+		errList[filename] = loadErr
+	} else {
+		log.Debug().Msgf("Loaded '%s' as teams config", path)
+
+		for _, v := range teamConfigs {
+			config.AppendTeam(v)
+		}
+	}
+}
+
+func loadOrgConfigFile(
+	config *core.Config,
+	filename string,
+	path string,
+	decoderOpts []yaml.DecodeOption,
+	errList map[string]error,
+) {
+	if config.Org != nil {
+		errList[filename] = errOrgConfigAlreadyLoaded
+
+		return
+	}
+
+	orgConfig, loadErr := core.LoadOrgFromFile(path, decoderOpts...)
+	if loadErr != nil {
+		errList[filename] = loadErr
+	} else {
+		log.Debug().Msgf("Loaded '%s' as organization config", path)
+
+		config.Org = orgConfig
+	}
+}
+
+func loadOrgRulesetsConfigFile(
+	config *core.Config,
+	filename string,
+	path string,
+	decoderOpts []yaml.DecodeOption,
+	errList map[string]error,
+) {
+	rulesetConfigs, loadErr := core.LoadOrgRulesetsFromFile(path, decoderOpts...)
+	if loadErr != nil {
+		errList[filename] = loadErr
+	} else {
+		log.Debug().Msgf("Loaded '%s' as organization rulesets config", path)
+
+		for _, v := range rulesetConfigs {
+			config.AppendOrgRuleset(v)
+		}
+	}
+}
+
+func loadCustomPropertiesConfigFile(
+	config *core.Config,
+	filename string,
+	path string,
+	decoderOpts []yaml.DecodeOption,
+	errList map[string]error,
+) {
+	propertyConfigs, loadErr := core.LoadCustomPropertiesFromFile(path, decoderOpts...)
+	if loadErr != nil {
+		errList[filename] = loadErr
+	} else {
+		log.Debug().Msgf("Loaded '%s' as custom properties config", path)
+
+		for _, v := range propertyConfigs {
+			config.AppendCustomProperty(v)
+		}
+	}
+}
+
 func readRepositoryDirectory(
 	config *core.Config,
 	rootPath string,
@@ -289,6 +391,66 @@ func loadTemplateFromFile(config *core.Config, filePath string, decoderOpts []ya
 
 			log.Debug().Msgf("Loaded '%s' as branch protection template", filePath)
 		}
+	case strings.HasSuffix(tplName, ".team"):
+		tplName = strings.TrimSuffix(tplName, ".team")
+
+		tpl, err := core.LoadTeamTemplateFromFile(filePath, decoderOpts...)
+		if err != nil {
+			//nolint:wrapcheck // Expected to return error as is
+			return err
+		}
+
+		config.Templates.Teams[tplName] = tpl
+
+		log.Debug().Msgf("Loaded '%s' as team template", filePath)
+	case strings.HasSuffix(tplName, ".ruleset"):
+		tplName = strings.TrimSuffix(tplName, ".ruleset")
+
+		tpl, err := core.LoadRulesetTemplateFromFile(filePath, decoderOpts...)
+		if err != nil {
+			//nolint:wrapcheck // Expected to return error as is
+			return err
+		}
+
+		config.Templates.Rulesets[tplName] = tpl
+
+		log.Debug().Msgf("Loaded '%s' as ruleset template", filePath)
+	case strings.HasSuffix(tplName, ".environment"):
+		tplName = strings.TrimSuffix(tplName, ".environment")
+
+		tpl, err := core.LoadEnvironmentTemplateFromFile(filePath, decoderOpts...)
+		if err != nil {
+			//nolint:wrapcheck // Expected to return error as is
+			return err
+		}
+
+		config.Templates.Environments[tplName] = tpl
+
+		log.Debug().Msgf("Loaded '%s' as environment template", filePath)
+	case strings.HasSuffix(tplName, ".webhook"):
+		tplName = strings.TrimSuffix(tplName, ".webhook")
+
+		tpl, err := core.LoadWebhookTemplateFromFile(filePath, decoderOpts...)
+		if err != nil {
+			//nolint:wrapcheck // Expected to return error as is
+			return err
+		}
+
+		config.Templates.Webhooks[tplName] = tpl
+
+		log.Debug().Msgf("Loaded '%s' as webhook template", filePath)
+	case strings.HasSuffix(tplName, ".labels"):
+		tplName = strings.TrimSuffix(tplName, ".labels")
+
+		tpl, err := core.LoadLabelsTemplateFromFile(filePath, decoderOpts...)
+		if err != nil {
+			//nolint:wrapcheck // Expected to return error as is
+			return err
+		}
+
+		config.Templates.Labels[tplName] = tpl
+
+		log.Debug().Msgf("Loaded '%s' as labels template", filePath)
 	default:
 		log.Debug().Msgf("%s is not a known template type => ignored", filePath)
 	}